@@ -0,0 +1,65 @@
+package table
+
+import "github.com/charmbracelet/lipgloss"
+
+// DoubleHeaderBorder returns a border for the header separator that uses a
+// double line with ╞ ╪ ╡ junctions, for use with SetHeaderBorder.
+func DoubleHeaderBorder() lipgloss.Border {
+	border := lipgloss.NormalBorder()
+	border.Top = "═"
+	border.MiddleLeft = "╞"
+	border.Middle = "╪"
+	border.MiddleRight = "╡"
+	return border
+}
+
+// SetBorder sets the glyphs used for the frame and separators, such as
+// lipgloss.NormalBorder(), RoundedBorder(), ThickBorder(), DoubleBorder(),
+// ASCIIBorder(), HiddenBorder() or MarkdownBorder(). It also resets the
+// header separator to the same border.
+func (m *Model) SetBorder(border lipgloss.Border) {
+	m.border = border
+	m.headerBorder = border
+}
+
+// SetHeaderBorder sets the glyphs used for the line between the headers and
+// the rows. Its Top is used for the line and MiddleLeft, Middle and
+// MiddleRight for the junctions.
+func (m *Model) SetHeaderBorder(border lipgloss.Border) {
+	m.headerBorder = border
+}
+
+// ShowFrame sets whether to draw an outer frame around the table
+func (m *Model) ShowFrame(show bool) {
+	m.showFrame = show
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// ShowHeaderSeparator sets whether to draw a line between the headers and the rows
+func (m *Model) ShowHeaderSeparator(show bool) {
+	m.showHeaderSeparator = show
+	m.ensureVisible()
+}
+
+// ShowColumnSeparators sets whether to draw vertical lines between columns
+func (m *Model) ShowColumnSeparators(show bool) {
+	m.showColumnSeparators = show
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// ShowRowSeparators sets whether to draw horizontal lines between rows
+func (m *Model) ShowRowSeparators(show bool) {
+	m.showRowSeparators = show
+	m.ensureVisible()
+}
+
+// contentWidth returns the width available to columns inside the frame
+func (m Model) contentWidth() int {
+	width := m.width - m.gutterWidth() - m.scrollbarWidth()
+	if m.showFrame {
+		width -= 2
+	}
+	return max(width, 0)
+}
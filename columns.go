@@ -0,0 +1,290 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Overflow defines what happens when the columns are wider than the table
+type Overflow int
+
+const (
+	OverflowScroll Overflow = iota // Scroll horizontally to reach clipped columns
+	OverflowHide                   // Hide low priority columns until the rest fit
+)
+
+// SetOverflow sets how the table handles columns wider than its width. With
+// OverflowHide, columns with the lowest priority are hidden first and come
+// back when the table grows.
+func (m *Model) SetOverflow(overflow Overflow) {
+	m.overflow = overflow
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// SetColumnPriority sets how important a column is when columns are hidden
+// to fit the width. Higher priorities are kept longer; the default is 0.
+// Among equal priorities, columns further right are hidden first.
+func (m *Model) SetColumnPriority(col, priority int) {
+	m.priorities[col] = priority
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// HiddenColumnCount returns how many columns are hidden to fit the width,
+// not counting columns hidden with HideColumn
+func (m Model) HiddenColumnCount() int {
+	hidden := len(m.columnWidths) - len(m.columnOrder)
+	for colIdx := range m.hiddenColumns {
+		if colIdx < len(m.columnWidths) {
+			hidden--
+		}
+	}
+
+	return hidden
+}
+
+// HideColumn hides a column without changing the data. The last visible
+// column cannot be hidden.
+func (m *Model) HideColumn(col int) {
+	visible := 0
+	for colIdx := range m.columnWidths {
+		if !m.hiddenColumns[colIdx] {
+			visible++
+		}
+	}
+	if col < 0 || col >= len(m.columnWidths) || m.hiddenColumns[col] || visible <= 1 {
+		return
+	}
+
+	m.hiddenColumns[col] = true
+	m.arrangeColumns()
+}
+
+// ShowColumn shows a column hidden with HideColumn
+func (m *Model) ShowColumn(col int) {
+	if !m.hiddenColumns[col] {
+		return
+	}
+
+	delete(m.hiddenColumns, col)
+	m.arrangeColumns()
+}
+
+// ShowAllColumns shows every column hidden with HideColumn
+func (m *Model) ShowAllColumns() {
+	clear(m.hiddenColumns)
+	m.arrangeColumns()
+}
+
+// HiddenColumns returns the columns hidden with HideColumn in display order
+func (m Model) HiddenColumns() []int {
+	var hidden []int
+	for _, colIdx := range m.displayOrder {
+		if m.hiddenColumns[colIdx] {
+			hidden = append(hidden, colIdx)
+		}
+	}
+
+	return hidden
+}
+
+// MoveColumn moves a column past offset displayed columns, to the left for
+// a negative offset and to the right for a positive one. Column indices
+// used elsewhere in the API keep referring to the data.
+func (m *Model) MoveColumn(col, offset int) {
+	pos := m.viewIndex(col)
+	if pos < 0 || offset == 0 {
+		return
+	}
+
+	target := m.columnOrder[max(min(pos+offset, len(m.columnOrder)-1), 0)]
+	if target == col {
+		return
+	}
+
+	order := slices.DeleteFunc(slices.Clone(m.displayOrder), func(colIdx int) bool {
+		return colIdx == col
+	})
+	at := slices.Index(order, target)
+	if offset > 0 {
+		at++
+	}
+
+	m.displayOrder = slices.Insert(order, at, col)
+	m.arrangeColumns()
+}
+
+// SetColumnOrder sets the display order of the columns by data index.
+// Columns left out are displayed after the listed ones in data order.
+func (m *Model) SetColumnOrder(order []int) {
+	m.displayOrder = slices.Clone(order)
+	m.arrangeColumns()
+}
+
+// ColumnOrder returns the data index of every column in display order,
+// including hidden columns
+func (m Model) ColumnOrder() []int {
+	return slices.Clone(m.displayOrder)
+}
+
+// arrangeColumns lays the columns out again after they are hidden, shown or
+// moved, keeping search matches in display order
+func (m *Model) arrangeColumns() {
+	m.calculateColumnWidths()
+	if m.searchQuery != "" {
+		m.updateSearchMatches()
+		m.resetSearchPosition()
+	}
+	m.ensureVisible()
+}
+
+// normalizeOrder returns order limited to columns below numCols without
+// duplicates, followed by any missing columns in data order
+func normalizeOrder(order []int, numCols int) []int {
+	seen := make([]bool, numCols)
+	normalized := make([]int, 0, numCols)
+	for _, colIdx := range order {
+		if colIdx >= 0 && colIdx < numCols && !seen[colIdx] {
+			seen[colIdx] = true
+			normalized = append(normalized, colIdx)
+		}
+	}
+
+	for colIdx := range numCols {
+		if !seen[colIdx] {
+			normalized = append(normalized, colIdx)
+		}
+	}
+
+	return normalized
+}
+
+// hideColumnsByPriority keeps the most important columns that fit in width,
+// taking higher priorities first and then columns further left. Columns
+// keep their display order and at least one column is always kept.
+func (m *Model) hideColumnsByPriority(width int) {
+	byImportance := slices.Clone(m.columnOrder)
+	slices.SortStableFunc(byImportance, func(a, b int) int {
+		return m.priorities[b] - m.priorities[a]
+	})
+
+	kept := make(map[int]bool)
+	used := 0
+	for _, colIdx := range byImportance {
+		needed := m.columnWidths[colIdx]
+		if len(kept) > 0 && m.showColumnSeparators {
+			needed++
+		}
+		if len(kept) > 0 && used+needed > width {
+			continue
+		}
+
+		kept[colIdx] = true
+		used += needed
+	}
+
+	m.columnOrder = slices.DeleteFunc(m.columnOrder, func(colIdx int) bool {
+		return !kept[colIdx]
+	})
+}
+
+// displayedWidth returns the total width of the displayed columns and the
+// separators between them
+func (m Model) displayedWidth() int {
+	total := 0
+	for _, colIdx := range m.columnOrder {
+		total += m.columnWidths[colIdx]
+	}
+
+	if m.showColumnSeparators && len(m.columnOrder) > 1 {
+		total += len(m.columnOrder) - 1
+	}
+
+	return total
+}
+
+// viewIndex returns the display position of a data column, or -1 if the
+// column is not displayed
+func (m Model) viewIndex(col int) int {
+	return slices.Index(m.columnOrder, col)
+}
+
+// columnStart returns the horizontal position where the column displayed at
+// pos starts, before scrolling
+func (m Model) columnStart(pos int) int {
+	start := 0
+	for _, colIdx := range m.columnOrder[:pos] {
+		start += m.columnWidths[colIdx]
+		if m.showColumnSeparators {
+			start++
+		}
+	}
+
+	return start
+}
+
+// clampSelectedColumn moves the selection to the nearest displayed column
+// when the selected column is hidden, preferring the one to its right
+func (m *Model) clampSelectedColumn() {
+	if len(m.columnOrder) == 0 || m.viewIndex(m.selectedCol) >= 0 {
+		return
+	}
+
+	selected := m.columnRank(m.selectedCol)
+	nearest := m.columnOrder[0]
+	for _, colIdx := range m.columnOrder {
+		if abs(m.columnRank(colIdx)-selected) <= abs(m.columnRank(nearest)-selected) {
+			nearest = colIdx
+		}
+	}
+
+	m.selectedCol = nearest
+}
+
+// columnRank returns the position of a data column in the user's display
+// order, counting hidden columns
+func (m Model) columnRank(col int) int {
+	if rank := slices.Index(m.displayOrder, col); rank >= 0 {
+		return rank
+	}
+	return col
+}
+
+// hiddenIndicator draws the number of hidden columns over the right end of
+// the first line of text, such as " +5 cols "
+func (m Model) hiddenIndicator(text string) string {
+	hidden := m.HiddenColumnCount()
+	if hidden <= 0 {
+		return text
+	}
+
+	indicator := m.theme.Indicator.Render(hiddenLabel(hidden))
+	x := lipgloss.Width(text) - lipgloss.Width(indicator)
+	if m.showFrame {
+		x--
+	}
+	if x < 0 {
+		return text
+	}
+
+	return overlay(text, indicator, x, 0)
+}
+
+// hiddenLabel returns the indicator text for a number of hidden columns
+func hiddenLabel(hidden int) string {
+	if hidden == 1 {
+		return " +1 col "
+	}
+	return fmt.Sprintf(" +%d cols ", hidden)
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,160 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// OpenDetail opens a popup showing the full content of the selected cell.
+// JSON values are pretty-printed.
+func (m *Model) OpenDetail() {
+	if _, ok := m.GetSelectedCell(); !ok {
+		return
+	}
+
+	m.showDetail = true
+	m.layoutDetail()
+}
+
+// CloseDetail closes the cell detail popup
+func (m *Model) CloseDetail() {
+	m.showDetail = false
+}
+
+// ShowingDetail reports whether the cell detail popup is open
+func (m Model) ShowingDetail() bool {
+	return m.showDetail
+}
+
+// IsTruncated reports whether the content of a cell is cut off, either
+// because it is too wide for its column or too tall for its row
+func (m Model) IsTruncated(row, col int) bool {
+	if row < 0 || row >= len(m.rows) || col < 0 || col >= len(m.columnWidths) {
+		return false
+	}
+
+	width := max(m.cellWidth(row, col)-2, 0)
+	lines := m.wrappedLines(m.rows[row], row, col, false)
+	for _, line := range lines {
+		if ansi.StringWidth(line) > width {
+			return true
+		}
+	}
+
+	return len(lines) > m.rowHeight(m.rows[row], row, false, m.bodyHeight())
+}
+
+// updateDetail handles messages while the cell detail popup is open
+func (m Model) updateDetail(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keyMap.CloseDetail), key.Matches(msg, m.keyMap.ShowDetail):
+			m.CloseDetail()
+		case key.Matches(msg, m.keyMap.Up):
+			m.detail.ScrollUp(1)
+		case key.Matches(msg, m.keyMap.Down):
+			m.detail.ScrollDown(1)
+		case key.Matches(msg, m.keyMap.PageUp):
+			m.detail.PageUp()
+		case key.Matches(msg, m.keyMap.PageDown):
+			m.detail.PageDown()
+		case key.Matches(msg, m.keyMap.Home):
+			m.detail.GotoTop()
+		case key.Matches(msg, m.keyMap.End):
+			m.detail.GotoBottom()
+		}
+	}
+
+	return m, nil
+}
+
+// layoutDetail sizes the popup to its content within the table and fills it
+func (m *Model) layoutDetail() {
+	cell, _ := m.GetSelectedCell()
+	content := prettyJSON(cell)
+
+	frameWidth, frameHeight := m.theme.Popup.GetFrameSize()
+
+	// Leave a margin so the table stays visible around the popup
+	maxWidth := max(m.width-frameWidth-4, 10)
+	maxHeight := max(m.height-frameHeight-3, 1)
+
+	width := 0
+	for line := range strings.SplitSeq(content, "\n") {
+		width = max(width, ansi.StringWidth(line))
+	}
+	width = min(max(width, ansi.StringWidth(m.detailTitle())), maxWidth)
+
+	content = ansi.Wrap(content, width, "")
+	height := min(strings.Count(content, "\n")+1, maxHeight)
+
+	m.detail = viewport.New(width, height)
+	m.detail.SetContent(content)
+}
+
+// detailTitle returns the header of the selected column
+func (m Model) detailTitle() string {
+	if m.selectedCol < len(m.headers) {
+		return m.headers[m.selectedCol]
+	}
+	return ""
+}
+
+// renderDetail draws the cell detail popup centred over the table view
+func (m Model) renderDetail(view string) string {
+	title := ansi.Truncate(m.detailTitle(), m.detail.Width, m.ellipsis)
+	popup := m.theme.Popup.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.theme.Header.Render(title),
+		m.detail.View(),
+	))
+
+	x := max((m.width-lipgloss.Width(popup))/2, 0)
+	y := max((m.height-lipgloss.Height(popup))/2, 0)
+
+	return overlay(view, popup, x, y)
+}
+
+// overlay draws fg over bg with its top left corner at column x and line y
+func overlay(bg, fg string, x, y int) string {
+	bgLines := strings.Split(bg, "\n")
+	fgLines := strings.Split(fg, "\n")
+
+	for len(bgLines) < y+len(fgLines) {
+		bgLines = append(bgLines, "")
+	}
+
+	for i, fgLine := range fgLines {
+		bgLine := bgLines[y+i]
+		left := ansi.Truncate(bgLine, x, "")
+		left += strings.Repeat(" ", x-ansi.StringWidth(left))
+		right := ansi.TruncateLeft(bgLine, x+ansi.StringWidth(fgLine), "")
+
+		// Reset styles so the background's colours don't bleed into the popup
+		bgLines[y+i] = left + ansi.ResetStyle + fgLine + ansi.ResetStyle + right
+	}
+
+	return strings.Join(bgLines, "\n")
+}
+
+// prettyJSON indents s if it is a JSON object or array, otherwise returns it unchanged
+func prettyJSON(s string) string {
+	trimmed := strings.TrimSpace(s)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return s
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(trimmed), "", "  "); err != nil {
+		return s
+	}
+
+	return buf.String()
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/help"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	table "github.com/ionut-t/gotable"
@@ -12,11 +13,42 @@ import (
 
 type model struct {
 	table       table.Model
+	help        help.Model
 	width       int
 	height      int
 	infoStyle   lipgloss.Style
 	showBorders bool
 	showHeaders bool
+	showFrame   bool
+	borderIdx   int
+	hideColumns bool
+	layoutIdx   int
+	rowNumbers  table.RowNumbers
+	scrollbars  bool
+
+	showColumnSeparators bool
+	showRowSeparators    bool
+}
+
+// borders are the border styles cycled through with the "s" key
+var borders = []lipgloss.Border{
+	lipgloss.NormalBorder(),
+	lipgloss.RoundedBorder(),
+	lipgloss.ThickBorder(),
+	lipgloss.DoubleBorder(),
+	lipgloss.ASCIIBorder(),
+	lipgloss.MarkdownBorder(),
+	lipgloss.HiddenBorder(),
+}
+
+// layouts are the column layouts cycled through with the "L" key
+var layouts = []table.LayoutStrategy{
+	table.FitContentLayout{},
+	table.ShrinkLayout{},
+	table.EqualLayout{},
+	table.FlexLayout{Weights: map[int]int{0: 0, 1: 2, 7: 2}},
+	table.PercentLayout{Percents: map[int]int{1: 20, 7: 25}},
+	table.FitContentLayout{Compact: true},
 }
 
 func initialModel() model {
@@ -82,6 +114,20 @@ func initialModel() model {
 	}
 	t.SetRows(rows)
 
+	// Underline the header of the selected column
+	t.SetStyleFunc(func(row, col int, value string, state table.CellState) lipgloss.Style {
+		if state.Header && state.Selected {
+			return lipgloss.NewStyle().Underline(true)
+		}
+		return lipgloss.NewStyle()
+	})
+
+	// Stripe alternate rows
+	theme := table.DefaultTheme()
+	theme.AltCell = lipgloss.NewStyle().Background(lipgloss.Color("235"))
+	theme.AltRow = theme.AltCell
+	t.SetTheme(theme)
+
 	// Set initial size (will be updated on window size message)
 	t.SetSize(80, 20)
 
@@ -97,19 +143,75 @@ func initialModel() model {
 		Foreground(lipgloss.Color("214"))
 	t.SetColumnStyle(6, statusStyle)
 
-	// Set custom row style for on-leave employee
-	onLeaveStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("243")).
-		Italic(true)
-	t.SetRowStyle(6, onLeaveStyle) // Row 7 (index 6)
+	// Draw tenure as a gauge, remote as a check mark and hire dates relative to now
+	t.SetColumnRenderer(5, table.BarRenderer{Max: 12, Width: 12})
+	t.SetColumnRenderer(10, table.RelativeTimeRenderer{})
+	t.SetColumnRenderer(14, table.BoolRenderer{
+		TrueStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("42")),
+		FalseStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("203")),
+	})
+
+	// Keep both the user and the domain of long email addresses visible
+	t.SetColumnWidth(7, 18)
+	t.SetColumnTruncation(7, table.TruncateMiddle)
+
+	// Band related columns under group headers
+	t.SetColumnGroups([]table.ColumnGroup{
+		{Title: "Employee", Columns: []int{0, 1, 2}},
+		{Title: "Compensation", Columns: []int{3}},
+		{Title: "Contact", Columns: []int{7, 8}},
+		{Title: "Assignment", Columns: []int{12, 13, 14}},
+	})
+
+	// Flag the employee on leave in the gutter
+	t.SetRowMarker(6, "⚑")
+
+	// Size columns for typical values rather than the longest one
+	t.SetWidthSampling(table.WidthSampling{Rows: 1000, Percentile: 95})
+	t.SetColumnMinWidth(1, 12)
+	t.SetColumnMaxWidth(4, 12)
+
+	// Keep the most useful columns when hiding columns to fit the width
+	t.SetColumnPriority(0, 3)
+	t.SetColumnPriority(1, 3)
+	t.SetColumnPriority(2, 2)
+	t.SetColumnPriority(6, 2)
+	t.SetColumnPriority(3, 1)
+	t.SetColumnPriority(7, 1)
+
+	// Grey out employees on leave, wherever their row ends up
+	t.AddRule(table.Rule{
+		Column:    6,
+		Condition: table.Equals("On Leave"),
+		Style: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("243")).
+			Italic(true),
+		Row:   true,
+		Layer: table.RuleAboveColumnStyle,
+	})
+
+	// Highlight top salaries
+	t.AddRule(table.Rule{
+		Column:    3,
+		Condition: table.GreaterThan(130000),
+		Style: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("48")).
+			Bold(true).
+			Underline(true),
+		Layer: table.RuleAboveColumnStyle,
+	})
 
 	// Create model
 	m := model{
 		table: t,
+		help:  help.New(),
 		infoStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("241")).
 			MarginTop(1),
 		showBorders: true,
+
+		showColumnSeparators: true,
+		showRowSeparators:    true,
 	}
 
 	return m
@@ -124,10 +226,16 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		// Reserve space for info line
-		m.table.SetSize(msg.Width, msg.Height-3)
+		// Reserve space for the info and help lines
+		m.table.SetSize(msg.Width, msg.Height-4)
+		m.help.Width = msg.Width
 
 	case tea.KeyMsg:
+		// Let the table handle all keys while search or a popup is open
+		if m.table.Searching() || m.table.ShowingDetail() || m.table.ShowingColumnPicker() {
+			break
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c", "esc":
 			return m, tea.Quit
@@ -144,7 +252,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Toggle cell selection mode
 			m.table.ToggleSelectionMode(table.SelectionCell)
 
-		case "n":
+		case "0":
 			// Clear all selection modes
 			m.table.SetSelectionMode(table.SelectionOff)
 
@@ -155,13 +263,68 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "b":
 			// Toggle borders
 			m.showBorders = !m.showBorders
+			m.showColumnSeparators = m.showBorders
+			m.showRowSeparators = m.showBorders
 			m.table.ShowBorders(m.showBorders)
 
+		case "|":
+			// Toggle column separators
+			m.showColumnSeparators = !m.showColumnSeparators
+			m.table.ShowColumnSeparators(m.showColumnSeparators)
+
+		case "-":
+			// Toggle row separators (compact mode when off)
+			m.showRowSeparators = !m.showRowSeparators
+			m.table.ShowRowSeparators(m.showRowSeparators)
+
+		case "f":
+			// Toggle outer frame
+			m.showFrame = !m.showFrame
+			m.table.ShowFrame(m.showFrame)
+
+		case "s":
+			// Cycle border styles
+			m.borderIdx = (m.borderIdx + 1) % len(borders)
+			m.table.SetBorder(borders[m.borderIdx])
+
+		case "o":
+			// Toggle between scrolling and hiding columns that don't fit
+			m.hideColumns = !m.hideColumns
+			if m.hideColumns {
+				m.table.SetOverflow(table.OverflowHide)
+			} else {
+				m.table.SetOverflow(table.OverflowScroll)
+			}
+
+		case "L":
+			// Cycle column layouts
+			m.layoutIdx = (m.layoutIdx + 1) % len(layouts)
+			m.table.SetLayout(layouts[m.layoutIdx])
+
+		case "#":
+			// Cycle row numbers: off, data, visible, relative
+			m.rowNumbers = (m.rowNumbers + 1) % 4
+			m.table.ShowRowNumbers(m.rowNumbers)
+
+		case "%":
+			// Toggle scrollbars and scroll arrows
+			m.scrollbars = !m.scrollbars
+			m.table.ShowVerticalScrollbar(m.scrollbars)
+			m.table.ShowHorizontalScrollbar(m.scrollbars)
+			m.table.ShowScrollArrows(m.scrollbars)
+
+		case "tab":
+			// Toggle focus, as when moving to another component
+			if !m.table.Focused() {
+				return m, m.table.Focus()
+			}
+			m.table.Blur()
+
 		case "h":
 			// Toggle headers
 			m.showHeaders = !m.showHeaders
 			m.table.ShowHeaders(m.showHeaders)
-			m.table.SetSize(m.width, m.height-3)
+			m.table.SetSize(m.width, m.height-4)
 		}
 	}
 
@@ -196,14 +359,16 @@ func (m model) View() string {
 	selectedCell, _ := m.table.GetSelectedCell()
 
 	info := fmt.Sprintf(
-		"Selection: %s | Row: %d, Col: %d | Cell: %s | Keys: (r)ow (c)ol (x)cell (a)ll (n)one (b)orders (h)eaders (q)uit",
+		"Selection: %s | %s | Cell: %s | Keys: (r)ow (c)ol (x)cell (a)ll (0)none (b)orders (|)col (-)row separators (f)rame border (s)tyle (o)verflow (L)ayout (#)numbers (%%)scrollbars (h)eaders (tab)focus (q)uit",
 		selectionMode,
-		m.table.GetSelectedRow()+1,
-		m.table.GetSelectedColumn()+1,
+		m.table.Status(),
 		selectedCell,
 	)
 
-	return tableView + "\n" + m.infoStyle.Render(info)
+	// Table keys that apply right now
+	helpView := m.help.View(m.table)
+
+	return tableView + "\n" + m.infoStyle.Render(info) + "\n" + helpView
 }
 
 func main() {
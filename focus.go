@@ -0,0 +1,61 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Focus focuses the table so it handles keys and shows its selection with
+// the selected styles. Tables start focused. The returned command keeps the
+// cursor of an open search input blinking.
+func (m *Model) Focus() tea.Cmd {
+	m.focus = true
+	if m.searching {
+		return m.searchInput.Focus()
+	}
+
+	return nil
+}
+
+// Blur removes focus from the table, such as when another component takes
+// the keys. A blurred table ignores keys and shows its selection with the
+// blurred styles, or faint selected styles when the theme leaves them unset.
+func (m *Model) Blur() {
+	m.focus = false
+	m.searchInput.Blur()
+}
+
+// Focused reports whether the table has focus
+func (m Model) Focused() bool {
+	return m.focus
+}
+
+// selectedRowStyle returns the style of the selected row for the focus
+func (m Model) selectedRowStyle() lipgloss.Style {
+	if m.focus {
+		return m.theme.SelectedRow
+	}
+	return blurred(m.theme.BlurredRow, m.theme.SelectedRow)
+}
+
+// selectedCellStyle returns the style of the selected cell for the focus
+func (m Model) selectedCellStyle() lipgloss.Style {
+	if m.focus {
+		return m.theme.SelectedCell
+	}
+	return blurred(m.theme.BlurredCell, m.theme.SelectedCell)
+}
+
+// blurred returns the blurred style, or a faint copy of the selected style
+// when the blurred style sets no colors or attributes, so the selection
+// stays visible with themes that predate the blurred styles
+func blurred(style, selected lipgloss.Style) lipgloss.Style {
+	_, noForeground := style.GetForeground().(lipgloss.NoColor)
+	_, noBackground := style.GetBackground().(lipgloss.NoColor)
+	if noForeground && noBackground && !style.GetBold() && !style.GetFaint() &&
+		!style.GetItalic() && !style.GetUnderline() && !style.GetReverse() {
+		return selected.Faint(true)
+	}
+
+	return style
+}
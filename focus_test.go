@@ -0,0 +1,72 @@
+package table
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestBlurredTableIgnoresKeys(t *testing.T) {
+	m := newRowsModel(3, nil)
+	m.Blur()
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if got := m.GetSelectedRow(); got != 0 {
+		t.Errorf("blurred table moved to row %d", got)
+	}
+	if m.cellState(0, 0, false).Focused {
+		t.Error("CellState.Focused is true while blurred")
+	}
+
+	m.Focus()
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if got := m.GetSelectedRow(); got != 1 {
+		t.Errorf("focused table selected row %d, want 1", got)
+	}
+}
+
+func TestFocusReturnsSearchCmd(t *testing.T) {
+	m := newRowsModel(3, nil)
+	if cmd := m.Focus(); cmd != nil {
+		t.Error("Focus returned a command without a search open")
+	}
+
+	m.StartSearch()
+	m.Blur()
+	if cmd := m.Focus(); cmd == nil {
+		t.Error("Focus dropped the search input's command")
+	}
+	if !m.searchInput.Focused() {
+		t.Error("search input not focused again")
+	}
+}
+
+func TestBlurredStyles(t *testing.T) {
+	selected := lipgloss.NewStyle().Background(lipgloss.Color("57"))
+	custom := lipgloss.NewStyle().Background(lipgloss.Color("237"))
+
+	tests := []struct {
+		name      string
+		style     lipgloss.Style
+		wantBg    lipgloss.TerminalColor
+		wantFaint bool
+	}{
+		{"set", custom, lipgloss.Color("237"), false},
+		{"zero value", lipgloss.Style{}, lipgloss.Color("57"), true},
+		{"empty style", lipgloss.NewStyle(), lipgloss.Color("57"), true},
+		{"attribute only", lipgloss.NewStyle().Underline(true), lipgloss.NoColor{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := blurred(tt.style, selected)
+			if got.GetBackground() != tt.wantBg {
+				t.Errorf("background = %v, want %v", got.GetBackground(), tt.wantBg)
+			}
+			if got.GetFaint() != tt.wantFaint {
+				t.Errorf("faint = %v, want %v", got.GetFaint(), tt.wantFaint)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package table
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NumberFormatter formats numbers with thousands separators and a fixed
+// number of decimals, such as 1,234,567.89
+func NumberFormatter(decimals int) Formatter {
+	return func(value any) string {
+		v, ok := toFloat(value)
+		if !ok {
+			return formatValue(value)
+		}
+		return groupThousands(strconv.FormatFloat(v, 'f', decimals, 64))
+	}
+}
+
+// FixedFormatter formats numbers with a fixed number of decimals and no
+// separators, such as 1234567.89
+func FixedFormatter(decimals int) Formatter {
+	return func(value any) string {
+		v, ok := toFloat(value)
+		if !ok {
+			return formatValue(value)
+		}
+		return strconv.FormatFloat(v, 'f', decimals, 64)
+	}
+}
+
+// CurrencyFormatter formats numbers as amounts with a currency symbol and
+// thousands separators, such as $120,000 or -$1,250.50
+func CurrencyFormatter(symbol string, decimals int) Formatter {
+	return func(value any) string {
+		v, ok := toFloat(value)
+		if !ok {
+			return formatValue(value)
+		}
+
+		sign := ""
+		if v < 0 {
+			sign = "-"
+			v = -v
+		}
+
+		return sign + symbol + groupThousands(strconv.FormatFloat(v, 'f', decimals, 64))
+	}
+}
+
+// TimeFormatter formats time.Time values with the given layout
+func TimeFormatter(layout string) Formatter {
+	return func(value any) string {
+		t, ok := value.(time.Time)
+		if !ok {
+			return formatValue(value)
+		}
+		return t.Format(layout)
+	}
+}
+
+// BytesFormatter formats byte counts using binary units, such as 1.5 KiB
+func BytesFormatter() Formatter {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+	return func(value any) string {
+		v, ok := toFloat(value)
+		if !ok {
+			return formatValue(value)
+		}
+
+		unit := 0
+		for math.Abs(v) >= 1024 && unit < len(units)-1 {
+			v /= 1024
+			unit++
+		}
+
+		if unit == 0 {
+			return fmt.Sprintf("%.0f %s", v, units[unit])
+		}
+		return fmt.Sprintf("%.1f %s", v, units[unit])
+	}
+}
+
+// DurationFormatter formats time.Duration values using their two largest
+// units, such as 3d 4h, 2h 5m or 45s
+func DurationFormatter() Formatter {
+	units := []struct {
+		suffix string
+		size   time.Duration
+	}{
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	}
+
+	return func(value any) string {
+		d, ok := value.(time.Duration)
+		if !ok {
+			return formatValue(value)
+		}
+
+		sign := ""
+		if d < 0 {
+			sign = "-"
+			d = -d
+		}
+
+		if d < time.Second {
+			return sign + d.String()
+		}
+
+		var parts []string
+		for _, unit := range units {
+			if n := d / unit.size; n > 0 {
+				parts = append(parts, fmt.Sprintf("%d%s", n, unit.suffix))
+				d -= n * unit.size
+			}
+			if len(parts) == 2 {
+				break
+			}
+		}
+
+		return sign + strings.Join(parts, " ")
+	}
+}
+
+// groupThousands inserts commas between groups of three digits in the
+// integer part of a formatted number
+func groupThousands(number string) string {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign = "-"
+		number = number[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+
+	var result strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			result.WriteByte(',')
+		}
+		result.WriteRune(digit)
+	}
+
+	if hasFraction {
+		return sign + result.String() + "." + fraction
+	}
+	return sign + result.String()
+}
@@ -0,0 +1,66 @@
+package table
+
+import "github.com/charmbracelet/lipgloss"
+
+// ColumnGroup is a header band spanning several columns, such as
+// "Compensation" over Salary and Bonus
+type ColumnGroup struct {
+	Title   string
+	Columns []int // Data columns under the band
+}
+
+// SetColumnGroups sets bands of grouped headers drawn above the column
+// headers, one line per level from the top down. Columns of a group that
+// are displayed next to each other share one cell; columns outside every
+// group of a level get an empty cell.
+func (m *Model) SetColumnGroups(levels ...[]ColumnGroup) {
+	m.columnGroups = levels
+	m.ensureVisible()
+}
+
+// groupSpans returns the spans of one level of column groups over the
+// displayed columns
+func (m Model) groupSpans(level int) []span {
+	groupOf := make(map[int]int)
+	for i, group := range m.columnGroups[level] {
+		for _, colIdx := range group.Columns {
+			groupOf[colIdx] = i
+		}
+	}
+
+	var spans []span
+	for pos, colIdx := range m.columnOrder {
+		group, grouped := groupOf[colIdx]
+
+		// Extend the previous span over neighbouring columns of its group
+		if grouped && pos > 0 {
+			if prev, ok := groupOf[m.columnOrder[pos-1]]; ok && prev == group {
+				spans[len(spans)-1].last = pos
+				continue
+			}
+		}
+
+		s := span{first: pos, last: pos, style: m.theme.Header, align: lipgloss.Center}
+		if grouped {
+			s.text = m.columnGroups[level][group].Title
+		}
+		spans = append(spans, s)
+	}
+
+	return spans
+}
+
+// groupHeight returns the number of lines taken by the column group bands
+// and the dividers below them
+func (m Model) groupHeight() int {
+	if !m.showHeaders {
+		return 0
+	}
+
+	height := len(m.columnGroups)
+	if m.showHeaderSeparator {
+		height *= 2
+	}
+
+	return height
+}
@@ -0,0 +1,141 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// RowNumbers defines how the gutter numbers rows
+type RowNumbers int
+
+const (
+	RowNumbersOff      RowNumbers = iota // No row numbers
+	RowNumbersData                       // Position of the row in the data, from 1
+	RowNumbersVisible                    // Position of the row on screen, from 1
+	RowNumbersRelative                   // Distance from the selected row, which shows its own number
+)
+
+// ShowRowNumbers shows row numbers in a gutter left of the columns. The
+// gutter stays in place when scrolling horizontally and is not a column,
+// so column indices are unchanged.
+func (m *Model) ShowRowNumbers(mode RowNumbers) {
+	m.rowNumbers = mode
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// SetRowMarker sets a short marker shown in the gutter next to a row, such
+// as an icon for a bookmark, an error or a modified row. An empty marker
+// removes it.
+func (m *Model) SetRowMarker(row int, marker string) {
+	if marker == "" {
+		delete(m.rowMarkers, row)
+	} else {
+		m.rowMarkers[row] = marker
+	}
+
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// ClearRowMarkers removes every row marker
+func (m *Model) ClearRowMarkers() {
+	clear(m.rowMarkers)
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// numberWidth returns the width of the row numbers, 0 when they are off
+func (m Model) numberWidth() int {
+	if m.rowNumbers == RowNumbersOff {
+		return 0
+	}
+	return len(strconv.Itoa(max(len(m.rows), 1)))
+}
+
+// markerWidth returns the width of the widest row marker
+func (m Model) markerWidth() int {
+	width := 0
+	for _, marker := range m.rowMarkers {
+		width = max(width, ansi.StringWidth(marker))
+	}
+
+	return width
+}
+
+// gutterWidth returns the width of the gutter, including its separator, or
+// 0 when there is no gutter
+func (m Model) gutterWidth() int {
+	numberWidth, markerWidth := m.numberWidth(), m.markerWidth()
+	if numberWidth == 0 && markerWidth == 0 {
+		return 0
+	}
+
+	width := 1 + numberWidth + 1
+	if numberWidth > 0 && markerWidth > 0 {
+		width++
+	}
+	width += markerWidth
+
+	if m.showColumnSeparators {
+		width++
+	}
+
+	return width
+}
+
+// rowNumber returns the number shown in the gutter for a row
+func (m Model) rowNumber(rowIdx int) int {
+	switch m.rowNumbers {
+	case RowNumbersVisible:
+		return rowIdx - m.offsetY + 1
+	case RowNumbersRelative:
+		if rowIdx != m.selectedRow {
+			return abs(rowIdx - m.selectedRow)
+		}
+	}
+
+	return rowIdx + 1
+}
+
+// renderGutter renders the gutter on one line of a row, with its separator.
+// Numbers and markers show on the first line of data rows; headers get an
+// empty gutter in their style.
+func (m Model) renderGutter(rowIdx, line int, isHeader bool) string {
+	if m.gutterWidth() == 0 {
+		return ""
+	}
+
+	numberWidth, markerWidth := m.numberWidth(), m.markerWidth()
+
+	number, marker := "", ""
+	if !isHeader && line == 0 && m.rowKinds[rowIdx] != RowSection {
+		if numberWidth > 0 {
+			number = strconv.Itoa(m.rowNumber(rowIdx))
+		}
+		marker = m.rowMarkers[rowIdx]
+	}
+
+	var content strings.Builder
+	content.WriteString(" ")
+	if numberWidth > 0 {
+		content.WriteString(strings.Repeat(" ", max(numberWidth-len(number), 0)) + number + " ")
+	}
+	if markerWidth > 0 {
+		content.WriteString(marker + strings.Repeat(" ", markerWidth-ansi.StringWidth(marker)+1))
+	}
+
+	style := m.theme.Gutter
+	if isHeader {
+		style = m.theme.Header
+	}
+
+	gutter := style.Render(content.String())
+	if m.showColumnSeparators {
+		gutter += m.theme.Border.Render(m.border.Left)
+	}
+
+	return gutter
+}
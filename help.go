@@ -0,0 +1,85 @@
+package table
+
+import "github.com/charmbracelet/bubbles/key"
+
+// ShortHelp returns the primary bindings for the short help view,
+// implementing help.KeyMap. It lists every binding whatever the table's
+// state; pass the Model to help.Model instead to show only the keys that
+// apply.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Search, k.ShowDetail}
+}
+
+// FullHelp returns the bindings for the full help view grouped into
+// navigation, search, views, columns and the keys of open popups,
+// implementing help.KeyMap
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right, k.Home, k.End, k.PageUp, k.PageDown},
+		{k.Search, k.NextMatch, k.PrevMatch},
+		{k.ShowDetail, k.ToggleRecord},
+		{k.HideColumn, k.ShowColumns, k.MoveColumnLeft, k.MoveColumnRight},
+		{k.AcceptSearch, k.CancelSearch, k.CloseDetail, k.RestoreColumn, k.ClosePicker},
+	}
+}
+
+// ShortHelp returns the bindings for the short help view that apply in the
+// table's current state, implementing help.KeyMap so the table can be passed
+// to help.Model directly. While the search input or a popup is open it lists
+// that mode's keys.
+func (m Model) ShortHelp() []key.Binding {
+	k := m.HelpKeys()
+
+	switch {
+	case m.searching:
+		return []key.Binding{k.AcceptSearch, k.CancelSearch}
+	case m.showDetail:
+		return []key.Binding{k.Up, k.Down, k.CloseDetail}
+	case m.showPicker:
+		return []key.Binding{k.Up, k.Down, k.RestoreColumn, k.ClosePicker}
+	}
+
+	return k.ShortHelp()
+}
+
+// FullHelp returns the bindings for the full help view that apply in the
+// table's current state, implementing help.KeyMap
+func (m Model) FullHelp() [][]key.Binding {
+	return m.HelpKeys().FullHelp()
+}
+
+// HelpKeys returns the key map with the bindings that do nothing in the
+// table's current state disabled. help.Model leaves disabled bindings out,
+// which is how the Model's own ShortHelp and FullHelp reflect the active
+// features; use HelpKeys to build custom help from them. Only the keys of
+// an open popup or search input are enabled, match navigation needs a
+// search, column keys need column or cell selection and the column picker
+// needs hidden columns. A blurred table has every binding disabled.
+func (m Model) HelpKeys() KeyMap {
+	k := m.keyMap
+
+	enable := func(on bool, bindings ...*key.Binding) {
+		for _, b := range bindings {
+			b.SetEnabled(on && b.Enabled())
+		}
+	}
+
+	searching := m.focus && m.searching
+	detail := m.focus && !m.searching && m.showDetail
+	picker := m.focus && !m.searching && !m.showDetail && m.showPicker
+	table := m.focus && !m.searching && !m.showDetail && !m.showPicker
+	columns := m.columnSelection()
+
+	enable(table || detail || picker, &k.Up, &k.Down)
+	enable(table || detail, &k.Home, &k.End, &k.PageUp, &k.PageDown)
+	enable(table, &k.Left, &k.Right, &k.Search, &k.ShowDetail, &k.ToggleRecord)
+	enable(table && len(m.searchMatches) > 0, &k.NextMatch, &k.PrevMatch)
+	enable(table && columns, &k.HideColumn, &k.MoveColumnLeft, &k.MoveColumnRight)
+	enable(table && len(m.hiddenColumns) > 0, &k.ShowColumns)
+
+	enable(searching, &k.AcceptSearch, &k.CancelSearch)
+	enable(detail, &k.CloseDetail)
+	enable(picker, &k.RestoreColumn, &k.ClosePicker)
+
+	return k
+}
@@ -0,0 +1,75 @@
+package table
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// helpKeys returns the help keys of the enabled bindings
+func helpKeys(bindings []key.Binding) []string {
+	var keys []string
+	for _, b := range bindings {
+		if b.Enabled() {
+			keys = append(keys, b.Help().Key)
+		}
+	}
+
+	return keys
+}
+
+func TestShortHelp(t *testing.T) {
+	var _ help.KeyMap = Model{}
+	var _ help.KeyMap = KeyMap{}
+
+	tests := []struct {
+		name  string
+		setup func(m *Model)
+		want  []string
+	}{
+		{"table", func(m *Model) {}, []string{"↑/k", "↓/j", "←/h", "→/l", "/", "v"}},
+		{"search", func(m *Model) { m.StartSearch() }, []string{"enter", "esc"}},
+		{"detail", func(m *Model) { m.OpenDetail() }, []string{"↑/k", "↓/j", "esc"}},
+		{"blurred", func(m *Model) { m.Blur() }, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"A", "B"})
+			m.SetRows([][]string{{"1", "2"}})
+			m.SetSize(40, 10)
+			tt.setup(&m)
+
+			if got := helpKeys(m.ShortHelp()); !slices.Equal(got, tt.want) {
+				t.Errorf("ShortHelp() keys = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHelpKeysColumnBindings(t *testing.T) {
+	m := New()
+	m.SetHeaders([]string{"A", "B"})
+	m.SetRows([][]string{{"1", "2"}})
+	m.SetSize(40, 10)
+
+	if m.HelpKeys().MoveColumnLeft.Enabled() {
+		t.Error("column keys enabled with only row selection")
+	}
+
+	m.SetSelectionMode(SelectionCell)
+	if !m.HelpKeys().MoveColumnLeft.Enabled() {
+		t.Error("column keys disabled with cell selection")
+	}
+	if m.HelpKeys().ShowColumns.Enabled() {
+		t.Error("column picker key enabled with no hidden columns")
+	}
+
+	m.HideColumn(1)
+	if !m.HelpKeys().ShowColumns.Enabled() {
+		t.Error("column picker key disabled with a hidden column")
+	}
+}
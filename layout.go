@@ -0,0 +1,270 @@
+package table
+
+// LayoutStrategy decides the widths of the displayed columns
+type LayoutStrategy interface {
+	// Layout returns the width of each column, padding included, given the
+	// columns in display order and the width available to them once
+	// separators are taken away
+	Layout(columns []LayoutColumn, available int) []int
+}
+
+// LayoutColumn describes a displayed column to a LayoutStrategy. Widths
+// outside Min and Max are clamped after the layout runs.
+type LayoutColumn struct {
+	Index   int  // Data column index
+	Natural int  // Width of the widest content, padding included
+	Fixed   bool // Width set with SetColumnWidth, which layouts should keep
+	Min     int  // Narrowest width, padding included, 0 if unset
+	Max     int  // Widest width, padding included, 0 if unset
+}
+
+// clamp limits width to the column's minimum and maximum
+func (c LayoutColumn) clamp(width int) int {
+	if c.Max > 0 {
+		width = min(width, c.Max)
+	}
+	return max(width, c.Min)
+}
+
+// SetLayout sets the strategy used to size the columns, FitContentLayout
+// by default
+func (m *Model) SetLayout(layout LayoutStrategy) {
+	m.layout = layout
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// FitContentLayout sizes columns to their content. Extra space is spread
+// over the columns in proportion to their width unless Compact is set.
+// Columns wider than the table scroll horizontally.
+type FitContentLayout struct {
+	Compact bool // Leave extra space unused
+}
+
+// Layout implements LayoutStrategy
+func (l FitContentLayout) Layout(columns []LayoutColumn, available int) []int {
+	widths := naturalWidths(columns)
+	if !l.Compact {
+		spread(widths, columns, available-sum(widths), func(i int) int {
+			return columns[i].Natural
+		})
+	}
+
+	return widths
+}
+
+// EqualLayout gives every column the same share of the width
+type EqualLayout struct{}
+
+// Layout implements LayoutStrategy
+func (EqualLayout) Layout(columns []LayoutColumn, available int) []int {
+	widths := flexBasis(columns, func(LayoutColumn) bool { return true })
+	spread(widths, columns, available-sum(widths), func(int) int { return 1 })
+
+	return clampWidths(widths, columns)
+}
+
+// FlexLayout shares the width between columns in proportion to their
+// weights, keyed by data column. Columns without a weight have weight 1;
+// columns with weight 0 keep their content width.
+type FlexLayout struct {
+	Weights map[int]int
+}
+
+// Layout implements LayoutStrategy
+func (l FlexLayout) Layout(columns []LayoutColumn, available int) []int {
+	weight := func(i int) int {
+		if w, ok := l.Weights[columns[i].Index]; ok {
+			return max(w, 0)
+		}
+		return 1
+	}
+
+	widths := flexBasis(columns, func(col LayoutColumn) bool {
+		w, ok := l.Weights[col.Index]
+		return !ok || w > 0
+	})
+	spread(widths, columns, available-sum(widths), weight)
+
+	return clampWidths(widths, columns)
+}
+
+// FixedLayout sets the content width of columns, keyed by data column.
+// Other columns keep their content width and extra space is left unused.
+type FixedLayout struct {
+	Widths map[int]int
+}
+
+// Layout implements LayoutStrategy
+func (l FixedLayout) Layout(columns []LayoutColumn, available int) []int {
+	widths := naturalWidths(columns)
+	for i, col := range columns {
+		if w, ok := l.Widths[col.Index]; ok && !col.Fixed {
+			widths[i] = max(w, 0) + 2
+		}
+	}
+
+	return clampWidths(widths, columns)
+}
+
+// PercentLayout gives columns a percentage of the width, keyed by data
+// column. Other columns fit their content and share what is left over.
+type PercentLayout struct {
+	Percents map[int]int
+}
+
+// Layout implements LayoutStrategy
+func (l PercentLayout) Layout(columns []LayoutColumn, available int) []int {
+	widths := naturalWidths(columns)
+	for i, col := range columns {
+		if p, ok := l.Percents[col.Index]; ok && !col.Fixed {
+			widths[i] = col.clamp(max(available*p/100, 0))
+		}
+	}
+
+	spread(widths, columns, available-sum(widths), func(i int) int {
+		if _, ok := l.Percents[columns[i].Index]; ok {
+			return 0
+		}
+		return columns[i].Natural
+	})
+
+	return widths
+}
+
+// ShrinkLayout fits columns to their content like FitContentLayout, but
+// when they are wider than the table it narrows the widest columns first
+// instead of scrolling. Columns never shrink below their minimum width or
+// MinWidth, 3 if unset.
+type ShrinkLayout struct {
+	MinWidth int // Narrowest width, padding included
+}
+
+// Layout implements LayoutStrategy
+func (l ShrinkLayout) Layout(columns []LayoutColumn, available int) []int {
+	widths := FitContentLayout{}.Layout(columns, available)
+
+	minWidth := l.MinWidth
+	if minWidth <= 0 {
+		minWidth = 3
+	}
+
+	// Take one cell at a time from the widest flexible column
+	for excess := sum(widths) - available; excess > 0; excess-- {
+		widest := -1
+		for i, col := range columns {
+			if !col.Fixed && widths[i] > max(minWidth, col.Min) && (widest < 0 || widths[i] > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+
+		widths[widest]--
+	}
+
+	return widths
+}
+
+// naturalWidths returns the content width of each column
+func naturalWidths(columns []LayoutColumn) []int {
+	widths := make([]int, len(columns))
+	for i, col := range columns {
+		widths[i] = col.Natural
+	}
+
+	return widths
+}
+
+// flexBasis returns the starting widths for layouts that share the space
+// between columns: zero for flexible columns, the content width for the rest
+func flexBasis(columns []LayoutColumn, flexible func(LayoutColumn) bool) []int {
+	widths := naturalWidths(columns)
+	for i, col := range columns {
+		if !col.Fixed && flexible(col) {
+			widths[i] = 0
+		}
+	}
+
+	return widths
+}
+
+// spread adds extra width to the columns that are not fixed, in proportion
+// to their weight. The last weighted column takes the rounding remainder.
+// Columns stop growing at their maximum width and what they can't take is
+// shared among the others. Nothing is added when extra is not positive.
+func spread(widths []int, columns []LayoutColumn, extra int, weight func(i int) int) {
+	growable := func(i int) bool {
+		col := columns[i]
+		return !col.Fixed && weight(i) > 0 && (col.Max <= 0 || widths[i] < col.Max)
+	}
+
+	for extra > 0 {
+		totalWeight := 0
+		last := -1
+		for i := range columns {
+			if growable(i) {
+				totalWeight += weight(i)
+				last = i
+			}
+		}
+		if last < 0 {
+			return
+		}
+
+		// Columns whose share reaches their maximum are filled first, then
+		// the others share what is left
+		capped := false
+		for i, col := range columns {
+			if growable(i) && col.Max > 0 && widths[i]+weight(i)*extra/totalWeight >= col.Max {
+				extra -= col.Max - widths[i]
+				widths[i] = col.Max
+				capped = true
+			}
+		}
+		if capped {
+			continue
+		}
+
+		distributed := 0
+		for i, col := range columns {
+			if !growable(i) {
+				continue
+			}
+
+			share := weight(i) * extra / totalWeight
+			if i == last {
+				// Last column gets the remaining space to avoid rounding errors
+				share = extra - distributed
+			}
+			if col.Max > 0 {
+				share = min(share, col.Max-widths[i])
+			}
+
+			widths[i] += share
+			distributed += share
+		}
+
+		extra -= distributed
+	}
+}
+
+// clampWidths limits each width to its column's minimum and maximum
+func clampWidths(widths []int, columns []LayoutColumn) []int {
+	for i, col := range columns {
+		widths[i] = col.clamp(widths[i])
+	}
+
+	return widths
+}
+
+// sum returns the total of widths
+func sum(widths []int) int {
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+
+	return total
+}
@@ -0,0 +1,127 @@
+package table
+
+import (
+	"slices"
+	"testing"
+)
+
+// layoutColumns returns flexible columns with the given natural widths
+func layoutColumns(naturals ...int) []LayoutColumn {
+	columns := make([]LayoutColumn, len(naturals))
+	for i, natural := range naturals {
+		columns[i] = LayoutColumn{Index: i, Natural: natural}
+	}
+
+	return columns
+}
+
+func TestSpread(t *testing.T) {
+	tests := []struct {
+		name    string
+		widths  []int
+		columns []LayoutColumn
+		extra   int
+		weights []int
+		want    []int
+	}{
+		{"even", []int{0, 0}, layoutColumns(5, 5), 10, []int{1, 1}, []int{5, 5}},
+		{"remainder to last", []int{0, 0, 0}, layoutColumns(5, 5, 5), 10, []int{1, 1, 1}, []int{3, 3, 4}},
+		{"weighted", []int{0, 0}, layoutColumns(5, 5), 9, []int{1, 2}, []int{3, 6}},
+		{"zero weight", []int{4, 0}, layoutColumns(4, 5), 7, []int{0, 1}, []int{4, 7}},
+		{"nothing to spread", []int{4, 4}, layoutColumns(4, 4), 0, []int{1, 1}, []int{4, 4}},
+		{
+			"fixed kept",
+			[]int{6, 0},
+			[]LayoutColumn{{Natural: 6, Fixed: true}, {Natural: 3}},
+			10, []int{1, 1}, []int{6, 10},
+		},
+		{
+			"max passes on the rest",
+			[]int{0, 0, 0},
+			[]LayoutColumn{{Natural: 3, Max: 4}, {Natural: 3}, {Natural: 3}},
+			20, []int{1, 1, 1}, []int{4, 8, 8},
+		},
+		{
+			"every column at max",
+			[]int{0, 0},
+			[]LayoutColumn{{Max: 3}, {Max: 4}},
+			20, []int{1, 1}, []int{3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widths := slices.Clone(tt.widths)
+			spread(widths, tt.columns, tt.extra, func(i int) int { return tt.weights[i] })
+
+			if !slices.Equal(widths, tt.want) {
+				t.Errorf("spread() = %v, want %v", widths, tt.want)
+			}
+		})
+	}
+}
+
+func TestShrinkLayout(t *testing.T) {
+	tests := []struct {
+		name      string
+		layout    ShrinkLayout
+		columns   []LayoutColumn
+		available int
+		want      []int
+	}{
+		{"fits grows", ShrinkLayout{}, layoutColumns(5, 5), 14, []int{7, 7}},
+		{"widest first", ShrinkLayout{}, layoutColumns(20, 6, 4), 24, []int{14, 6, 4}},
+		{"evens out", ShrinkLayout{}, layoutColumns(10, 10, 10), 25, []int{8, 8, 9}},
+		{"stops at minimum", ShrinkLayout{MinWidth: 4}, layoutColumns(10, 10), 5, []int{4, 4}},
+		{
+			"keeps fixed and min",
+			ShrinkLayout{},
+			[]LayoutColumn{{Natural: 10, Fixed: true}, {Natural: 10, Min: 8}, {Natural: 10}},
+			24, []int{10, 8, 6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.layout.Layout(tt.columns, tt.available)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Layout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayoutsFillContentWidth(t *testing.T) {
+	layouts := []struct {
+		name    string
+		layout  LayoutStrategy
+		scrolls bool // Columns wider than the table keep their width
+	}{
+		{"fit content", FitContentLayout{}, true},
+		{"equal", EqualLayout{}, false},
+		{"flex", FlexLayout{Weights: map[int]int{0: 3, 2: 2}}, false},
+		{"percent", PercentLayout{Percents: map[int]int{1: 33}}, true},
+		{"shrink", ShrinkLayout{}, false},
+	}
+	sizes := []int{37, 50, 61, 80, 103}
+
+	for _, l := range layouts {
+		for _, width := range sizes {
+			m := New()
+			m.SetHeaders([]string{"Name", "Department", "Email", "Salary"})
+			m.SetRows([][]string{{"Ann", "Engineering", "ann@example.com", "125000"}})
+			m.SetLayout(l.layout)
+			m.SetSize(width, 10)
+
+			// Columns and the separators between them fill the table
+			total := len(m.columnOrder) - 1
+			for _, colIdx := range m.columnOrder {
+				total += m.columnWidths[colIdx]
+			}
+
+			if total != m.contentWidth() && !(l.scrolls && total > m.contentWidth()) {
+				t.Errorf("%s at width %d: columns take %d cells, want %d", l.name, width, total, m.contentWidth())
+			}
+		}
+	}
+}
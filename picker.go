@@ -0,0 +1,111 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// OpenColumnPicker opens a popup listing the columns hidden with HideColumn
+// so they can be shown again. It does nothing when no column is hidden.
+func (m *Model) OpenColumnPicker() {
+	if len(m.HiddenColumns()) == 0 {
+		return
+	}
+
+	m.showPicker = true
+	m.pickerIndex = 0
+}
+
+// CloseColumnPicker closes the hidden column picker
+func (m *Model) CloseColumnPicker() {
+	m.showPicker = false
+}
+
+// ShowingColumnPicker reports whether the hidden column picker is open
+func (m Model) ShowingColumnPicker() bool {
+	return m.showPicker
+}
+
+// updatePicker handles messages while the hidden column picker is open
+func (m Model) updatePicker(msg tea.Msg) (Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	hidden := m.HiddenColumns()
+	if len(hidden) == 0 {
+		m.CloseColumnPicker()
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.keyMap.ClosePicker), key.Matches(keyMsg, m.keyMap.ShowColumns):
+		m.CloseColumnPicker()
+	case key.Matches(keyMsg, m.keyMap.Up):
+		m.pickerIndex = max(m.pickerIndex-1, 0)
+	case key.Matches(keyMsg, m.keyMap.Down):
+		m.pickerIndex = min(m.pickerIndex+1, len(hidden)-1)
+	case key.Matches(keyMsg, m.keyMap.RestoreColumn):
+		// Show the column and select it, closing once nothing is hidden
+		col := hidden[m.pickerIndex]
+		m.ShowColumn(col)
+		m.selectedCol = col
+		m.ensureVisible()
+
+		if len(hidden) == 1 {
+			m.CloseColumnPicker()
+		}
+		m.pickerIndex = min(m.pickerIndex, len(hidden)-2)
+	}
+
+	return m, nil
+}
+
+// columnName returns the header of a column, or its number if it has none
+func (m Model) columnName(col int) string {
+	if col < len(m.headers) && m.headers[col] != "" {
+		return m.headers[col]
+	}
+	return fmt.Sprintf("Column %d", col+1)
+}
+
+// renderPicker draws the hidden column picker centred over the table view,
+// scrolled so the highlighted column is visible
+func (m Model) renderPicker(view string) string {
+	hidden := m.HiddenColumns()
+	title := "Hidden columns"
+
+	frameWidth, frameHeight := m.theme.Popup.GetFrameSize()
+	maxWidth := max(m.width-frameWidth-4, 10)
+	maxHeight := max(m.height-frameHeight-3, 1)
+
+	width := ansi.StringWidth(title)
+	for _, col := range hidden {
+		width = max(width, ansi.StringWidth(m.columnName(col))+2)
+	}
+	width = min(width, maxWidth)
+
+	offset := max(m.pickerIndex-maxHeight+1, 0)
+	end := min(offset+maxHeight, len(hidden))
+
+	lines := []string{m.theme.Header.Render(ansi.Truncate(title, width, m.ellipsis))}
+	for i := offset; i < end; i++ {
+		style := m.theme.Cell
+		if i == m.pickerIndex {
+			style = m.selectedCellStyle()
+		}
+		lines = append(lines, style.Render(padCell(m.columnName(hidden[i]), width, m.ellipsis)))
+	}
+
+	popup := m.theme.Popup.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	x := max((m.width-lipgloss.Width(popup))/2, 0)
+	y := max((m.height-lipgloss.Height(popup))/2, 0)
+
+	return overlay(view, popup, x, y)
+}
@@ -0,0 +1,110 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ShowRecordView sets whether the selected row is shown as a vertical form
+// of header and value pairs instead of the grid. Up and down move between
+// records and left and right between fields, so the grid keeps the same
+// selection when the record view is closed. It doesn't open while no row
+// can be selected, such as in a table of section rows.
+func (m *Model) ShowRecordView(show bool) {
+	if show && !m.isSelectable(m.selectedRow) {
+		return
+	}
+
+	m.recordView = show
+	m.ensureVisible()
+}
+
+// ToggleRecordView switches between the grid and the record view
+func (m *Model) ToggleRecordView() {
+	m.ShowRecordView(!m.recordView)
+}
+
+// RecordView reports whether the record view is shown
+func (m Model) RecordView() bool {
+	return m.recordView
+}
+
+// recordLines renders the selected row with one field per line, scrolled so
+// the selected field is visible
+func (m Model) recordLines() []string {
+	if !m.isSelectable(m.selectedRow) {
+		return nil
+	}
+
+	row := m.rows[m.selectedRow]
+
+	height := m.height - m.statusHeight()
+	if m.searching {
+		height--
+	}
+	height = max(height, 1)
+
+	// Label column fits the widest header, up to a third of the table
+	labelWidth := 0
+	for _, header := range m.headers {
+		labelWidth = max(labelWidth, ansi.StringWidth(header))
+	}
+	labelWidth = min(labelWidth+2, max(m.width/3, 3))
+	valueWidth := max(m.width-labelWidth-1, 0)
+
+	// The selected field is highlighted on its own, not as part of a selected row
+	fieldStyles := m
+	fieldStyles.selectionMode = SelectionOff
+
+	offset := max(m.viewIndex(m.selectedCol)-height+1, 0)
+	separator := m.theme.Border.Render(m.border.Left)
+
+	// Fields follow the column order, leaving out hidden columns
+	var lines []string
+	for _, colIdx := range m.columnOrder[min(offset, len(m.columnOrder)):] {
+		if len(lines) >= height {
+			break
+		}
+
+		header := ""
+		if colIdx < len(m.headers) {
+			header = m.headers[colIdx]
+		}
+
+		value := ""
+		if colIdx < len(row) {
+			value = row[colIdx]
+		}
+
+		style := fieldStyles.cellStyle(m.selectedRow, colIdx, false)
+		if m.styleFunc != nil {
+			state := m.cellState(m.selectedRow, colIdx, false)
+			style = m.styleFunc(m.selectedRow, colIdx, value, state).Inherit(style)
+		}
+		if colIdx == m.selectedCol {
+			style = m.selectedCellStyle()
+		}
+
+		// Show the first line of the value; the detail popup shows the rest
+		value, _, _ = strings.Cut(value, "\n")
+
+		lines = append(lines,
+			m.theme.Header.Render(padCell(header, labelWidth, m.ellipsis))+
+				separator+
+				style.Render(padCell(value, valueWidth, m.ellipsis)))
+	}
+
+	return lines
+}
+
+// padCell truncates text and pads it with one space on the left and spaces
+// on the right to exactly width cells
+func padCell(text string, width int, ellipsis string) string {
+	if width < 2 {
+		return strings.Repeat(" ", max(width, 0))
+	}
+
+	text = truncate(text, width-2, TruncateEnd, ellipsis)
+	return " " + text + strings.Repeat(" ", width-1-ansi.StringWidth(text))
+}
@@ -0,0 +1,27 @@
+package table
+
+import "testing"
+
+func TestRecordViewNeedsSelectableRow(t *testing.T) {
+	tests := []struct {
+		name  string
+		kinds map[int]RowKind
+		want  bool
+	}{
+		{"data rows", nil, true},
+		{"all sections", map[int]RowKind{0: RowSection, 1: RowSection}, false},
+		{"all disabled", map[int]RowKind{0: RowDisabled, 1: RowDisabled}, false},
+		{"section then data", map[int]RowKind{0: RowSection}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newRowsModel(2, tt.kinds)
+			m.ToggleRecordView()
+
+			if got := m.RecordView(); got != tt.want {
+				t.Errorf("RecordView() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package table
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// CellRenderer draws the content of the cells in a column, for cells that
+// are more than plain text such as gauges, checkboxes or badges
+type CellRenderer interface {
+	// Render returns the content of a cell, at most width cells wide
+	Render(value any, width int, state CellState) string
+
+	// MinWidth returns the width the content needs, excluding padding
+	MinWidth() int
+}
+
+// SetColumnRenderer sets a custom renderer for a column. Pass nil to render
+// the column as plain text again.
+func (m *Model) SetColumnRenderer(col int, renderer CellRenderer) {
+	if renderer == nil {
+		delete(m.columnRenderers, col)
+	} else {
+		m.columnRenderers[col] = renderer
+	}
+
+	m.calculateColumnWidths()
+}
+
+// renderStyled renders content in a cell style. Content styled on its own,
+// such as renderer output, ends its styles with a reset that would also end
+// the cell style, cutting off a selected row's background, so the cell style
+// starts again after every reset.
+func renderStyled(style lipgloss.Style, content string) string {
+	if !strings.Contains(content, "\x1b[") {
+		return style.Render(content)
+	}
+
+	const marker = "\x00"
+	plain := style.Inline(true).UnsetWidth().UnsetMaxWidth().UnsetTransform()
+	restart, _, found := strings.Cut(plain.Render(marker), marker)
+	if !found || restart == "" {
+		return style.Render(content)
+	}
+
+	for _, reset := range []string{"\x1b[0m", "\x1b[m"} {
+		content = strings.ReplaceAll(content, reset, reset+restart)
+	}
+
+	return style.Render(content)
+}
+
+// BarRenderer draws numeric values as a horizontal gauge
+type BarRenderer struct {
+	Max   float64 // Value of a full bar, 100 if zero
+	Width int     // Minimum bar width, 10 if zero
+	Full  string  // Filled glyph, "█" if empty
+	Empty string  // Unfilled glyph, "░" if empty
+
+	// FullStyle and EmptyStyle style the filled and unfilled parts
+	FullStyle  lipgloss.Style
+	EmptyStyle lipgloss.Style
+}
+
+// Render implements CellRenderer
+func (r BarRenderer) Render(value any, width int, _ CellState) string {
+	v, ok := toFloat(value)
+	if !ok {
+		return ""
+	}
+
+	maxValue := r.Max
+	if maxValue == 0 {
+		maxValue = 100
+	}
+
+	full := r.Full
+	if full == "" {
+		full = "█"
+	}
+	empty := r.Empty
+	if empty == "" {
+		empty = "░"
+	}
+
+	ratio := math.Max(0, math.Min(v/maxValue, 1))
+	filled := int(math.Round(ratio * float64(width)))
+
+	return r.FullStyle.Render(strings.Repeat(full, filled)) +
+		r.EmptyStyle.Render(strings.Repeat(empty, width-filled))
+}
+
+// MinWidth implements CellRenderer
+func (r BarRenderer) MinWidth() int {
+	if r.Width == 0 {
+		return 10
+	}
+	return r.Width
+}
+
+// BoolRenderer draws boolean values as symbols. Strings such as "yes",
+// "true" and "1" count as true.
+type BoolRenderer struct {
+	True  string // Symbol for true, "✓" if empty
+	False string // Symbol for false, "✗" if empty
+
+	// TrueStyle and FalseStyle style the symbols
+	TrueStyle  lipgloss.Style
+	FalseStyle lipgloss.Style
+}
+
+// Render implements CellRenderer
+func (r BoolRenderer) Render(value any, _ int, _ CellState) string {
+	b, ok := toBool(value)
+	if !ok {
+		return ""
+	}
+
+	if b {
+		if r.True == "" {
+			return r.TrueStyle.Render("✓")
+		}
+		return r.TrueStyle.Render(r.True)
+	}
+
+	if r.False == "" {
+		return r.FalseStyle.Render("✗")
+	}
+	return r.FalseStyle.Render(r.False)
+}
+
+// MinWidth implements CellRenderer
+func (r BoolRenderer) MinWidth() int {
+	return max(ansi.StringWidth(r.True), ansi.StringWidth(r.False), 1)
+}
+
+// PercentRenderer draws numeric values as right-aligned percentages
+type PercentRenderer struct {
+	Decimals int  // Digits after the decimal point
+	Ratio    bool // Values are fractions of 1, so 0.5 is drawn as 50%
+}
+
+// Render implements CellRenderer
+func (r PercentRenderer) Render(value any, width int, _ CellState) string {
+	v, ok := toFloat(value)
+	if !ok {
+		return ""
+	}
+
+	if r.Ratio {
+		v *= 100
+	}
+
+	text := strconv.FormatFloat(v, 'f', r.Decimals, 64) + "%"
+	return strings.Repeat(" ", max(width-ansi.StringWidth(text), 0)) + text
+}
+
+// MinWidth implements CellRenderer
+func (r PercentRenderer) MinWidth() int {
+	// Room for "100%" plus the decimal point and digits
+	if r.Decimals > 0 {
+		return 5 + r.Decimals
+	}
+	return 4
+}
+
+// RelativeTimeRenderer draws times relative to now, such as "3 days ago"
+type RelativeTimeRenderer struct {
+	Layout string           // Layout used to parse string values, time.DateOnly if empty
+	Now    func() time.Time // Current time, time.Now if nil
+}
+
+// Render implements CellRenderer
+func (r RelativeTimeRenderer) Render(value any, _ int, _ CellState) string {
+	var t time.Time
+
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case string:
+		layout := r.Layout
+		if layout == "" {
+			layout = time.DateOnly
+		}
+
+		parsed, err := time.Parse(layout, v)
+		if err != nil {
+			return v
+		}
+		t = parsed
+	default:
+		return fmt.Sprint(value)
+	}
+
+	now := time.Now()
+	if r.Now != nil {
+		now = r.Now()
+	}
+
+	return relativeTime(now.Sub(t))
+}
+
+// MinWidth implements CellRenderer
+func (r RelativeTimeRenderer) MinWidth() int {
+	return len("11 months ago")
+}
+
+// relativeTime describes a duration in its largest whole unit
+func relativeTime(d time.Duration) string {
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"year", 365 * 24 * time.Hour},
+		{"month", 30 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+
+	for _, unit := range units {
+		n := int(d / unit.size)
+		if n == 0 {
+			continue
+		}
+
+		text := fmt.Sprintf("%d %s", n, unit.name)
+		if n > 1 {
+			text += "s"
+		}
+
+		if future {
+			return "in " + text
+		}
+		return text + " ago"
+	}
+
+	return "just now"
+}
+
+// toFloat converts numeric values and formatted numeric strings to float64
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case string:
+		return parseNumber(v)
+	}
+
+	return 0, false
+}
+
+// toBool converts booleans and common boolean strings to bool
+func toBool(value any) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "yes", "y", "1", "on":
+			return true, true
+		case "false", "no", "n", "0", "off":
+			return false, true
+		}
+	}
+
+	return false, false
+}
@@ -0,0 +1,52 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestRendererKeepsSelectedBackground(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	defer lipgloss.SetColorProfile(profile)
+
+	green := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	renderers := []struct {
+		name     string
+		renderer CellRenderer
+		value    any
+	}{
+		{"bar", BarRenderer{FullStyle: green, EmptyStyle: green}, 50},
+		{"bool", BoolRenderer{TrueStyle: green}, true},
+	}
+
+	for _, tt := range renderers {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"Name", "Value"})
+			m.SetColumnRenderer(1, tt.renderer)
+			m.SetValues([][]any{{"Ann", tt.value}})
+			m.SetSize(40, 10)
+
+			background := m.theme.SelectedRow.Render("x")
+			restart, _, _ := strings.Cut(background, "x")
+
+			row := m.renderRow(m.rows[0], 0, false)
+			resets := strings.Count(row, "\x1b[0m") + strings.Count(row, "\x1b[m")
+			if resets == 0 {
+				t.Fatal("renderer output has no styles")
+			}
+
+			// Every reset inside the row is followed by the row style, or
+			// ends a cell before a separator or the end of the line
+			for i, part := range strings.Split(row, "\x1b[0m")[1:] {
+				if part != "" && !strings.HasPrefix(part, restart) && !strings.HasPrefix(part, "\x1b[38;5;240m") {
+					t.Errorf("reset %d is followed by %q, want the selected row style %q", i, part, restart)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package table
+
+// RowKind describes how a row takes part in the table
+type RowKind int
+
+const (
+	RowData     RowKind = iota // A selectable row of data
+	RowSection                 // A separator or title, such as "— Archived —", showing its first cell across the table
+	RowDisabled                // A dimmed row of data that cannot be selected
+)
+
+// SetRowKind sets the kind of a row. Section and disabled rows are skipped
+// by navigation and never selected. Row kinds are reset by SetRows and
+// SetValues, and rows out of range are ignored.
+func (m *Model) SetRowKind(row int, kind RowKind) {
+	if row < 0 || row >= len(m.rows) {
+		return
+	}
+
+	if kind == RowData {
+		delete(m.rowKinds, row)
+	} else {
+		m.rowKinds[row] = kind
+	}
+
+	m.calculateColumnWidths()
+	m.selectedRow = m.selectableRow(m.selectedRow, 1)
+	if m.searchQuery != "" {
+		m.updateSearchMatches()
+		m.resetSearchPosition()
+	}
+	m.ensureVisible()
+}
+
+// GetRowKind returns the kind of a row
+func (m Model) GetRowKind(row int) RowKind {
+	return m.rowKinds[row]
+}
+
+// DataRowCount returns the number of rows holding selectable data, which
+// excludes section and disabled rows
+func (m Model) DataRowCount() int {
+	count := len(m.rows)
+	for row := range m.rowKinds {
+		if row < len(m.rows) {
+			count--
+		}
+	}
+
+	return count
+}
+
+// isSelectable reports whether a row can be selected
+func (m Model) isSelectable(row int) bool {
+	return row >= 0 && row < len(m.rows) && m.rowKinds[row] == RowData
+}
+
+// selectableRow returns the nearest selectable row to row, looking in
+// direction dir first and then the other way. It returns row itself when
+// no row can be selected.
+func (m Model) selectableRow(row, dir int) int {
+	for _, d := range []int{dir, -dir} {
+		for r := row; r >= 0 && r < len(m.rows); r += d {
+			if m.isSelectable(r) {
+				return r
+			}
+		}
+	}
+
+	return row
+}
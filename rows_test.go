@@ -0,0 +1,110 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newRowsModel returns a table of n rows with the given row kinds
+func newRowsModel(n int, kinds map[int]RowKind) Model {
+	m := New()
+	m.SetHeaders([]string{"Name"})
+
+	rows := make([][]string, n)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprintf("row %d", i)}
+	}
+	m.SetRows(rows)
+	m.SetSize(40, 20)
+
+	for row, kind := range kinds {
+		m.SetRowKind(row, kind)
+	}
+
+	return m
+}
+
+func TestSelectionSkipsNonDataRows(t *testing.T) {
+	kinds := map[int]RowKind{0: RowSection, 2: RowDisabled, 3: RowSection, 5: RowDisabled}
+
+	tests := []struct {
+		name  string
+		start int
+		keys  []tea.KeyType
+		want  int
+	}{
+		{"starts on first data row", -1, nil, 1},
+		{"down skips disabled and section", 1, []tea.KeyType{tea.KeyDown}, 4},
+		{"down stops at last data row", 4, []tea.KeyType{tea.KeyDown}, 4},
+		{"up skips back over them", 4, []tea.KeyType{tea.KeyUp}, 1},
+		{"up stops at first data row", 1, []tea.KeyType{tea.KeyUp}, 1},
+		{"home", 4, []tea.KeyType{tea.KeyHome}, 1},
+		{"end", 1, []tea.KeyType{tea.KeyEnd}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newRowsModel(6, kinds)
+			if tt.start >= 0 {
+				m.SetSelectedCell(tt.start, 0)
+			}
+			for _, k := range tt.keys {
+				m, _ = m.Update(tea.KeyMsg{Type: k})
+			}
+
+			if got := m.GetSelectedRow(); got != tt.want {
+				t.Errorf("selected row = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectionAccessorsIgnoreNonDataRows(t *testing.T) {
+	m := newRowsModel(4, map[int]RowKind{1: RowSection, 2: RowDisabled})
+
+	m.SetSelectedCell(2, 0)
+	if got := m.GetSelectedRow(); got != 0 {
+		t.Errorf("SetSelectedCell on a disabled row selected row %d, want 0", got)
+	}
+
+	m.selectedRow = 1
+	if _, ok := m.GetSelectedCell(); ok {
+		t.Error("GetSelectedCell returned a section row")
+	}
+	if _, ok := m.GetSelectedValue(); ok {
+		t.Error("GetSelectedValue returned a section row")
+	}
+
+	if got := m.DataRowCount(); got != 2 {
+		t.Errorf("DataRowCount() = %d, want 2", got)
+	}
+}
+
+func TestSetRowsResetsRowKinds(t *testing.T) {
+	m := newRowsModel(3, map[int]RowKind{0: RowSection})
+	m.SetRows([][]string{{"new 0"}, {"new 1"}})
+
+	if got := m.GetRowKind(0); got != RowData {
+		t.Errorf("row kind after SetRows = %v, want RowData", got)
+	}
+	if _, ok := m.GetValue(0, 0); !ok || !m.isSelectable(0) {
+		t.Error("new row 0 can't be selected")
+	}
+
+	m.SetRowKind(5, RowSection)
+	if len(m.rowKinds) != 0 {
+		t.Error("SetRowKind stored a row out of range")
+	}
+}
+
+func TestEndOnEmptyTable(t *testing.T) {
+	m := newRowsModel(0, nil)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnd})
+
+	if got := m.GetSelectedRow(); got != 0 {
+		t.Errorf("selected row = %d, want 0", got)
+	}
+	_ = m.View()
+}
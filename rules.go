@@ -0,0 +1,135 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RuleLayer defines where a rule sits in the style precedence chain. Each
+// layer overrides everything beneath it.
+type RuleLayer int
+
+const (
+	RuleAboveTheme       RuleLayer = iota // Over theme styles, beneath custom row styles
+	RuleAboveRowStyle                     // Over custom row styles, beneath custom column styles
+	RuleAboveColumnStyle                  // Over custom column styles, beneath selection
+	RuleAboveSelection                    // Over everything, including selection and matches
+)
+
+// Rule styles cells based on their values at render time, so the style
+// follows the data instead of a fixed row or column index
+type Rule struct {
+	// Column is the index of the column whose value is tested
+	Column int
+
+	// Condition reports whether the rule applies to a cell's raw value,
+	// which is its string unless the table was populated with SetValues
+	Condition func(value any) bool
+
+	// Style replaces the style of the matching cell
+	Style lipgloss.Style
+
+	// Row applies the style to every cell in the row instead of only the
+	// tested cell
+	Row bool
+
+	// Layer sets the precedence of the rule. Rules in the same layer are
+	// applied in the order they were added, so later rules win.
+	Layer RuleLayer
+}
+
+// AddRule adds a conditional formatting rule
+func (m *Model) AddRule(rule Rule) {
+	m.rules = append(m.rules, rule)
+}
+
+// ClearRules removes all conditional formatting rules
+func (m *Model) ClearRules() {
+	m.rules = nil
+}
+
+// applyRules returns the style of the last rule in the layer that matches the
+// cell, or style if none match
+func (m Model) applyRules(style lipgloss.Style, layer RuleLayer, rowIdx, colIdx int) (lipgloss.Style, bool) {
+	if rowIdx < 0 || rowIdx >= len(m.rows) {
+		return style, false
+	}
+
+	row := m.rows[rowIdx]
+	applied := false
+
+	for _, rule := range m.rules {
+		if rule.Layer != layer || rule.Condition == nil {
+			continue
+		}
+		if !rule.Row && rule.Column != colIdx {
+			continue
+		}
+		if rule.Column < 0 || rule.Column >= len(row) {
+			continue
+		}
+
+		if rule.Condition(m.cellValue(rowIdx, rule.Column)) {
+			style = rule.Style
+			applied = true
+		}
+	}
+
+	return style, applied
+}
+
+// Equals returns a condition matching values whose default format equals s
+func Equals(s string) func(any) bool {
+	return func(value any) bool {
+		return formatValue(value) == s
+	}
+}
+
+// Contains returns a condition matching values whose default format
+// contains substr, ignoring case
+func Contains(substr string) func(any) bool {
+	substr = strings.ToLower(substr)
+	return func(value any) bool {
+		return strings.Contains(strings.ToLower(formatValue(value)), substr)
+	}
+}
+
+// GreaterThan returns a condition matching numeric values greater than n.
+// Strings are parsed as formatted numbers.
+func GreaterThan(n float64) func(any) bool {
+	return func(value any) bool {
+		v, ok := toFloat(value)
+		return ok && v > n
+	}
+}
+
+// LessThan returns a condition matching numeric values less than n.
+// Strings are parsed as formatted numbers.
+func LessThan(n float64) func(any) bool {
+	return func(value any) bool {
+		v, ok := toFloat(value)
+		return ok && v < n
+	}
+}
+
+// parseNumber parses a formatted number such as "$120,000" or "-3.5%",
+// ignoring currency symbols, grouping commas, percent signs and spaces.
+// Values with any other text, such as "ext 120001", are not numbers.
+func parseNumber(value string) (float64, bool) {
+	var cleaned strings.Builder
+	for _, r := range value {
+		switch {
+		case (r >= '0' && r <= '9') || r == '.' || r == '-' || r == '+':
+			cleaned.WriteRune(r)
+		case r == ',' || r == '%' || unicode.IsSpace(r) || unicode.Is(unicode.Sc, r):
+		default:
+			return 0, false
+		}
+	}
+
+	n, err := strconv.ParseFloat(cleaned.String(), 64)
+	return n, err == nil
+}
@@ -0,0 +1,108 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   float64
+		wantOK bool
+	}{
+		{"120000", 120000, true},
+		{"$120,000", 120000, true},
+		{"€ 1,250.50", 1250.5, true},
+		{"-3.5%", -3.5, true},
+		{" 42 ", 42, true},
+		{"+7", 7, true},
+		{"ext 120001", 0, false},
+		{"12 kg", 0, false},
+		{"v1.2", 0, false},
+		{"1e5", 0, false},
+		{"", 0, false},
+		{"$", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseNumber(tt.value)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseNumber(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	if GreaterThan(100000)("ext 120001") {
+		t.Error(`GreaterThan(100000) matches "ext 120001"`)
+	}
+}
+
+func TestRuleLayers(t *testing.T) {
+	ruleColor := lipgloss.Color("9")
+	rowColor := lipgloss.Color("1")
+	colColor := lipgloss.Color("2")
+	selectedColor := DefaultTheme().SelectedCell.GetForeground()
+
+	tests := []struct {
+		name     string
+		layer    RuleLayer
+		row      bool
+		cell     cellPos
+		want     lipgloss.TerminalColor
+		wantRule bool
+	}{
+		{"above theme", RuleAboveTheme, true, cellPos{3, 2}, ruleColor, true},
+		{"above theme under row style", RuleAboveTheme, true, cellPos{0, 2}, rowColor, false},
+		{"above theme under column style", RuleAboveTheme, false, cellPos{0, 1}, colColor, false},
+		{"above row style", RuleAboveRowStyle, true, cellPos{0, 2}, ruleColor, true},
+		{"above row style under column style", RuleAboveRowStyle, true, cellPos{0, 1}, colColor, false},
+		{"above column style", RuleAboveColumnStyle, false, cellPos{0, 1}, ruleColor, true},
+		{"above column style under selection", RuleAboveColumnStyle, false, cellPos{2, 1}, selectedColor, false},
+		{"above selection", RuleAboveSelection, false, cellPos{2, 1}, ruleColor, true},
+		{"row rule on another column", RuleAboveSelection, true, cellPos{2, 0}, ruleColor, true},
+		{"cell rule on another column", RuleAboveSelection, false, cellPos{2, 0}, nil, false},
+		{"value not matching", RuleAboveSelection, true, cellPos{1, 2}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"Name", "Amount", "Note"})
+			m.SetRows([][]string{
+				{"a", "$150", "x"},
+				{"b", "$50", "y"},
+				{"c", "$200", "z"},
+				{"d", "$300", "w"},
+			})
+			m.SetSize(40, 10)
+			m.SetRowStyle(0, lipgloss.NewStyle().Foreground(rowColor))
+			m.SetColumnStyle(1, lipgloss.NewStyle().Foreground(colColor))
+			m.SetSelectionMode(SelectionCell)
+			m.SetSelectedCell(2, 1)
+
+			m.AddRule(Rule{
+				Column:    1,
+				Condition: GreaterThan(100),
+				Style:     lipgloss.NewStyle().Foreground(ruleColor),
+				Row:       tt.row,
+				Layer:     tt.layer,
+			})
+
+			got := m.cellStyle(tt.cell.row, tt.cell.col, false).GetForeground()
+			if tt.want == nil {
+				// The rule must not change the style of the cell
+				m.ClearRules()
+				tt.want = m.cellStyle(tt.cell.row, tt.cell.col, false).GetForeground()
+			}
+			if got != tt.want {
+				t.Errorf("foreground = %v, want %v", got, tt.want)
+			}
+			if tt.wantRule != (got == ruleColor) {
+				t.Errorf("rule applied = %v, want %v", got == ruleColor, tt.wantRule)
+			}
+		})
+	}
+}
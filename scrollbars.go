@@ -0,0 +1,122 @@
+package table
+
+import "strings"
+
+// ShowVerticalScrollbar sets whether a scrollbar right of the table shows
+// which rows are in view
+func (m *Model) ShowVerticalScrollbar(show bool) {
+	m.showVerticalScrollbar = show
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// ShowHorizontalScrollbar sets whether a scrollbar below the table shows
+// which part of the columns is in view
+func (m *Model) ShowHorizontalScrollbar(show bool) {
+	m.showHorizontalScrollbar = show
+	m.ensureVisible()
+}
+
+// ShowScrollArrows sets whether arrows at the sides of the table, ◀ and ▶,
+// show that columns are cut off on that side
+func (m *Model) ShowScrollArrows(show bool) {
+	m.showScrollArrows = show
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// scrollbarWidth returns the width taken by the vertical scrollbar and the
+// scroll arrows
+func (m Model) scrollbarWidth() int {
+	width := 0
+	if m.showVerticalScrollbar {
+		width++
+	}
+	if m.showScrollArrows {
+		width += 2
+	}
+
+	return width
+}
+
+// scrollbarHeight returns the height taken by the horizontal scrollbar
+func (m Model) scrollbarHeight() int {
+	if m.showHorizontalScrollbar {
+		return 1
+	}
+	return 0
+}
+
+// scrollThumb returns the start and size of the thumb on a track of the
+// given length for a view of visible items out of total, scrolled by offset
+func scrollThumb(track, visible, total, offset int) (int, int) {
+	if total <= visible || track <= 0 {
+		return 0, track
+	}
+
+	size := max(track*visible/total, 1)
+	start := (track - size) * offset / (total - visible)
+
+	return max(min(start, track-size), 0), size
+}
+
+// renderScrollbars adds the scroll arrows and scrollbars around the lines
+// of the table. The arrows go on arrowLine and the vertical scrollbar runs
+// along the body lines from bodyStart to bodyEnd.
+func (m Model) renderScrollbars(lines []string, arrowLine, bodyStart, bodyEnd int) []string {
+	if m.showScrollArrows {
+		contentWidth := m.contentWidth()
+		left, right := " ", " "
+		if arrowLine >= 0 && m.offsetX > 0 {
+			left = "◀"
+		}
+		if arrowLine >= 0 && m.offsetX+contentWidth < m.displayedWidth() {
+			right = "▶"
+		}
+
+		for i := range lines {
+			if i == arrowLine {
+				lines[i] = m.theme.ScrollThumb.Render(left) + lines[i] + m.theme.ScrollThumb.Render(right)
+			} else {
+				lines[i] = " " + lines[i] + " "
+			}
+		}
+	}
+
+	if m.showVerticalScrollbar {
+		track := bodyEnd - bodyStart
+		start, size := scrollThumb(track, m.visibleRowCount(), len(m.rows), m.offsetY)
+
+		for i := range lines {
+			switch pos := i - bodyStart; {
+			case pos < 0 || pos >= track:
+				lines[i] += " "
+			case pos >= start && pos < start+size:
+				lines[i] += m.theme.ScrollThumb.Render("┃")
+			default:
+				lines[i] += m.theme.ScrollTrack.Render("│")
+			}
+		}
+	}
+
+	if m.showHorizontalScrollbar {
+		track := m.contentWidth()
+		start, size := scrollThumb(track, track, m.displayedWidth(), m.offsetX)
+
+		// Line the track up with the columns
+		indent := m.gutterWidth()
+		if m.showFrame {
+			indent++
+		}
+		if m.showScrollArrows {
+			indent++
+		}
+
+		lines = append(lines, strings.Repeat(" ", indent)+
+			m.theme.ScrollTrack.Render(strings.Repeat("─", start))+
+			m.theme.ScrollThumb.Render(strings.Repeat("━", size))+
+			m.theme.ScrollTrack.Render(strings.Repeat("─", max(track-start-size, 0))))
+	}
+
+	return lines
+}
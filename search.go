@@ -0,0 +1,235 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cellPos identifies a cell by its row and column index
+type cellPos struct {
+	row int
+	col int
+}
+
+// compareCellPos orders cells row by row, then column by column in display
+// order
+func (m Model) compareCellPos(a, b cellPos) int {
+	if a.row != b.row {
+		return a.row - b.row
+	}
+	return m.columnRank(a.col) - m.columnRank(b.col)
+}
+
+// StartSearch opens the search input. Matches are updated as the user types.
+func (m *Model) StartSearch() tea.Cmd {
+	m.searching = true
+	m.searchOrigin = cellPos{m.selectedRow, m.selectedCol}
+	m.searchInput.SetValue("")
+	return m.searchInput.Focus()
+}
+
+// SetSearch searches the rows for the given query and moves the selection
+// to the first match after the current position. Matching is case-insensitive.
+func (m *Model) SetSearch(query string) {
+	m.searchQuery = query
+	m.updateSearchMatches()
+	m.resetSearchPosition()
+
+	if len(m.searchMatches) == 0 {
+		return
+	}
+
+	// Stay on the selected cell if it matches
+	selected := cellPos{m.selectedRow, m.selectedCol}
+	if idx, found := slices.BinarySearchFunc(m.searchMatches, selected, m.compareCellPos); found {
+		m.searchIndex = idx
+		m.ensureVisible()
+		return
+	}
+
+	m.jumpToMatch(selected, 1)
+}
+
+// ClearSearch removes the current search and its highlights
+func (m *Model) ClearSearch() {
+	m.searching = false
+	m.searchInput.Blur()
+	m.searchInput.SetValue("")
+	m.searchQuery = ""
+	m.searchMatches = nil
+	m.resetSearchPosition()
+}
+
+// resetSearchPosition forgets the current match and whether the last jump
+// wrapped, after the matches changed under them
+func (m *Model) resetSearchPosition() {
+	m.searchIndex = -1
+	m.searchWrapped = false
+}
+
+// NextMatch moves the selection to the next match, wrapping around at the end
+func (m *Model) NextMatch() {
+	m.jumpToMatch(cellPos{m.selectedRow, m.selectedCol}, 1)
+}
+
+// PrevMatch moves the selection to the previous match, wrapping around at the start
+func (m *Model) PrevMatch() {
+	m.jumpToMatch(cellPos{m.selectedRow, m.selectedCol}, -1)
+}
+
+// Searching reports whether the search input is active
+func (m Model) Searching() bool {
+	return m.searching
+}
+
+// SearchQuery returns the current search query
+func (m Model) SearchQuery() string {
+	return m.searchQuery
+}
+
+// SearchMatches returns the 1-based index of the current match and the total
+// number of matches. current is 0 until the selection has jumped to a match.
+func (m Model) SearchMatches() (current, total int) {
+	return m.searchIndex + 1, len(m.searchMatches)
+}
+
+// SearchWrapped reports whether the last jump wrapped around the table
+func (m Model) SearchWrapped() bool {
+	return m.searchWrapped
+}
+
+// SearchStatus returns a short description of the search state, such as
+// "match 3/17", suitable for a status bar. It is empty when there is no search.
+func (m Model) SearchStatus() string {
+	if m.searchQuery == "" {
+		return ""
+	}
+
+	if len(m.searchMatches) == 0 {
+		return "no matches"
+	}
+
+	status := fmt.Sprintf("match %d/%d", m.searchIndex+1, len(m.searchMatches))
+	if m.searchWrapped {
+		status += " (wrapped)"
+	}
+
+	return status
+}
+
+// updateSearch handles messages while the search input is active
+func (m Model) updateSearch(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keyMap.AcceptSearch):
+			m.searching = false
+			m.searchInput.Blur()
+			return m, nil
+		case key.Matches(msg, m.keyMap.CancelSearch):
+			m.ClearSearch()
+			m.SetSelectedCell(m.searchOrigin.row, m.searchOrigin.col)
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+
+	if query := m.searchInput.Value(); query != m.searchQuery {
+		// Search incrementally from where the search was started
+		m.selectedRow, m.selectedCol = m.searchOrigin.row, m.searchOrigin.col
+		m.SetSearch(query)
+		m.ensureVisible()
+	}
+
+	return m, cmd
+}
+
+// updateSearchMatches collects every cell containing the search query,
+// skipping columns hidden with HideColumn and cells under merged cells
+func (m *Model) updateSearchMatches() {
+	m.searchMatches = nil
+	if m.searchQuery == "" {
+		return
+	}
+
+	numCols := 0
+	for _, row := range m.rows {
+		numCols = max(numCols, len(row))
+	}
+	order := normalizeOrder(m.displayOrder, numCols)
+
+	query := strings.ToLower(m.searchQuery)
+	for rowIdx, row := range m.rows {
+		// Only rows that can be selected can be jumped to
+		if !m.isSelectable(rowIdx) {
+			continue
+		}
+
+		for _, colIdx := range order {
+			if colIdx >= len(row) || m.hiddenColumns[colIdx] || m.coveredBySpan(rowIdx, colIdx) {
+				continue
+			}
+			if m.cellMatches(rowIdx, colIdx, query) {
+				m.searchMatches = append(m.searchMatches, cellPos{rowIdx, colIdx})
+			}
+		}
+	}
+}
+
+// cellMatches reports whether a cell's displayed text or, for typed values,
+// its raw value contains the lowercase query
+func (m Model) cellMatches(rowIdx, colIdx int, query string) bool {
+	if strings.Contains(strings.ToLower(m.rows[rowIdx][colIdx]), query) {
+		return true
+	}
+
+	if rowIdx < len(m.values) && colIdx < len(m.values[rowIdx]) {
+		raw := formatValue(m.values[rowIdx][colIdx])
+		return strings.Contains(strings.ToLower(raw), query)
+	}
+
+	return false
+}
+
+// jumpToMatch selects the first match after (dir > 0) or before (dir < 0) pos
+func (m *Model) jumpToMatch(pos cellPos, dir int) {
+	if len(m.searchMatches) == 0 {
+		return
+	}
+
+	idx, found := slices.BinarySearchFunc(m.searchMatches, pos, m.compareCellPos)
+	m.searchWrapped = false
+
+	if dir > 0 {
+		if found {
+			idx++
+		}
+		if idx >= len(m.searchMatches) {
+			idx = 0
+			m.searchWrapped = true
+		}
+	} else {
+		idx--
+		if idx < 0 {
+			idx = len(m.searchMatches) - 1
+			m.searchWrapped = true
+		}
+	}
+
+	m.searchIndex = idx
+	match := m.searchMatches[idx]
+	m.selectedRow = match.row
+	m.selectedCol = match.col
+	m.ensureVisible()
+}
+
+// isMatch reports whether the cell matches the current search
+func (m Model) isMatch(rowIdx, colIdx int) bool {
+	_, found := slices.BinarySearchFunc(m.searchMatches, cellPos{rowIdx, colIdx}, m.compareCellPos)
+	return found
+}
@@ -0,0 +1,122 @@
+package table
+
+import (
+	"slices"
+	"testing"
+)
+
+// newSearchModel returns a table where "x" appears in cells (0,1), (1,0),
+// (2,2) and (3,1)
+func newSearchModel() Model {
+	m := New()
+	m.SetHeaders([]string{"A", "B", "C"})
+	m.SetRows([][]string{
+		{"a", "x1", "c"},
+		{"x2", "b", "c"},
+		{"a", "b", "x3"},
+		{"a", "x4", "c"},
+	})
+	m.SetSelectionMode(SelectionCell)
+	m.SetSize(40, 10)
+
+	return m
+}
+
+func TestJumpToMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		start       cellPos
+		dir         int
+		want        cellPos
+		wantWrapped bool
+	}{
+		{"next from a match", cellPos{0, 1}, 1, cellPos{1, 0}, false},
+		{"next from between matches", cellPos{1, 1}, 1, cellPos{2, 2}, false},
+		{"next wraps to the first", cellPos{3, 1}, 1, cellPos{0, 1}, true},
+		{"next wraps from after the last", cellPos{3, 2}, 1, cellPos{0, 1}, true},
+		{"previous from a match", cellPos{2, 2}, -1, cellPos{1, 0}, false},
+		{"previous from between matches", cellPos{2, 0}, -1, cellPos{1, 0}, false},
+		{"previous wraps to the last", cellPos{0, 1}, -1, cellPos{3, 1}, true},
+		{"previous wraps from before the first", cellPos{0, 0}, -1, cellPos{3, 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSearchModel()
+			m.SetSearch("x")
+			m.jumpToMatch(tt.start, tt.dir)
+
+			if got := (cellPos{m.selectedRow, m.selectedCol}); got != tt.want {
+				t.Errorf("selected %v, want %v", got, tt.want)
+			}
+			if m.SearchWrapped() != tt.wantWrapped {
+				t.Errorf("wrapped = %v, want %v", m.SearchWrapped(), tt.wantWrapped)
+			}
+		})
+	}
+}
+
+func TestSearchMatchesSkipUnreachableCells(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(m *Model)
+		want  []cellPos
+	}{
+		{"all", func(m *Model) {}, []cellPos{{0, 1}, {1, 0}, {2, 2}, {3, 1}}},
+		{"hidden column", func(m *Model) { m.HideColumn(1) }, []cellPos{{1, 0}, {2, 2}}},
+		{"covered by a span", func(m *Model) { m.SetCellSpan(0, 0, 2, 1) }, []cellPos{{1, 0}, {2, 2}, {3, 1}}},
+		{"covered by a row span", func(m *Model) { m.SetCellSpan(1, 2, 1, 2) }, []cellPos{{0, 1}, {1, 0}, {3, 1}}},
+		{"section row", func(m *Model) { m.SetRowKind(2, RowSection) }, []cellPos{{0, 1}, {1, 0}, {3, 1}}},
+		{"disabled row", func(m *Model) { m.SetRowKind(3, RowDisabled) }, []cellPos{{0, 1}, {1, 0}, {2, 2}}},
+		{
+			"reordered columns",
+			func(m *Model) {
+				m.SetRows([][]string{{"x5", "b", "x6"}})
+				m.SetColumnOrder([]int{2, 1, 0})
+			},
+			[]cellPos{{0, 2}, {0, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSearchModel()
+			tt.setup(&m)
+			m.SetSearch("x")
+
+			if !slices.Equal(m.searchMatches, tt.want) {
+				t.Errorf("matches = %v, want %v", m.searchMatches, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPositionResetsWhenMatchesChange(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(m *Model)
+		want   string
+	}{
+		{"new rows", func(m *Model) { m.SetRows([][]string{{"x1", "b", "c"}}) }, "match 0/1"},
+		{"hidden column", func(m *Model) { m.HideColumn(2) }, "match 0/3"},
+		{"row kind", func(m *Model) { m.SetRowKind(2, RowDisabled) }, "match 0/3"},
+		{"span", func(m *Model) { m.SetCellSpan(0, 0, 2, 1) }, "match 0/3"},
+		{"new search", func(m *Model) { m.SetSearch("x2") }, "match 1/1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSearchModel()
+			m.SetSearch("x")
+			m.jumpToMatch(cellPos{3, 1}, 1)
+			if !m.SearchWrapped() {
+				t.Fatal("jump from the last match did not wrap")
+			}
+
+			tt.change(&m)
+			if got := m.SearchStatus(); got != tt.want {
+				t.Errorf("SearchStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
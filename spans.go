@@ -0,0 +1,320 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// cellSpan is the number of displayed columns and rows a merged body cell
+// covers
+type cellSpan struct {
+	cols int
+	rows int
+}
+
+// SetCellSpan merges a body cell with the cols-1 columns displayed after it
+// and the rows-1 rows below it, such as for section titles. The merged cell
+// shows the content of the first cell and is selected as a single cell.
+// Spanning one column and one row removes the merge. Spans must not overlap,
+// and cells out of range are ignored.
+func (m *Model) SetCellSpan(row, col, cols, rows int) {
+	if row < 0 || row >= len(m.rows) || col < 0 || col >= len(m.displayOrder) {
+		return
+	}
+
+	anchor := cellPos{row, col}
+	if cols <= 1 && rows <= 1 {
+		delete(m.cellSpans, anchor)
+	} else {
+		m.cellSpans[anchor] = cellSpan{max(cols, 1), max(rows, 1)}
+	}
+
+	m.spansChanged()
+}
+
+// ClearCellSpans removes every merged cell
+func (m *Model) ClearCellSpans() {
+	clear(m.cellSpans)
+	m.spansChanged()
+}
+
+// spansChanged lays out the columns again, moves the selection onto the
+// merged cell it is in and skips covered cells in the search matches
+func (m *Model) spansChanged() {
+	m.calculateColumnWidths()
+	m.selectedRow, m.selectedCol = m.spanAnchor(m.selectedRow, m.selectedCol)
+	if m.searchQuery != "" {
+		m.updateSearchMatches()
+		m.resetSearchPosition()
+	}
+	m.ensureVisible()
+}
+
+// spanAt returns the merged cell covering the column displayed at pos in a
+// row: its first cell and the display positions of its first and last
+// column. Any other cell covers just itself.
+func (m Model) spanAt(rowIdx, pos int) (anchor cellPos, first, last int) {
+	// Section rows show their first cell across the table
+	if m.rowKinds[rowIdx] == RowSection {
+		return cellPos{rowIdx, 0}, 0, len(m.columnOrder) - 1
+	}
+
+	for a, s := range m.cellSpans {
+		if rowIdx < a.row || rowIdx >= a.row+s.rows {
+			continue
+		}
+
+		start := m.viewIndex(a.col)
+		if start < 0 {
+			continue
+		}
+
+		end := min(start+s.cols, len(m.columnOrder)) - 1
+		if pos >= start && pos <= end {
+			return a, start, end
+		}
+	}
+
+	return cellPos{rowIdx, m.columnOrder[pos]}, pos, pos
+}
+
+// spanAnchor returns the first cell of the merged cell covering a cell, or
+// the cell itself
+func (m Model) spanAnchor(row, col int) (int, int) {
+	pos := m.viewIndex(col)
+	if pos < 0 {
+		return row, col
+	}
+
+	anchor, _, _ := m.spanAt(row, pos)
+	return anchor.row, anchor.col
+}
+
+// coveredBySpan reports whether a cell is hidden under a merged cell
+// starting at another cell
+func (m Model) coveredBySpan(row, col int) bool {
+	anchorRow, anchorCol := m.spanAnchor(row, col)
+	return anchorRow != row || anchorCol != col
+}
+
+// cellWidth returns the width of a cell, which for the first cell of a
+// merged cell is the width of every column it covers
+func (m Model) cellWidth(rowIdx, colIdx int) int {
+	if rowIdx >= 0 && colIdx == 0 && m.rowKinds[rowIdx] == RowSection && len(m.columnOrder) > 0 {
+		return m.spanWidth(0, len(m.columnOrder)-1)
+	}
+
+	if pos := m.viewIndex(colIdx); pos >= 0 && rowIdx >= 0 {
+		if anchor, first, last := m.spanAt(rowIdx, pos); anchor == (cellPos{rowIdx, colIdx}) {
+			return m.spanWidth(first, last)
+		}
+	}
+
+	return m.columnWidths[colIdx]
+}
+
+// rowStarts returns a function reporting whether a displayed column starts
+// a cell in a row, which is where the row has a separator before it
+func (m Model) rowStarts(rowIdx int) func(colIdx int) bool {
+	return func(colIdx int) bool {
+		pos := m.viewIndex(colIdx)
+		_, first, _ := m.spanAt(rowIdx, pos)
+		return first == pos
+	}
+}
+
+// rowContinues returns a function reporting whether a displayed column is
+// covered by a merged cell running on from a row into the next
+func (m Model) rowContinues(rowIdx int) func(colIdx int) bool {
+	return func(colIdx int) bool {
+		anchor, _, _ := m.spanAt(rowIdx, m.viewIndex(colIdx))
+		s, ok := m.cellSpans[anchor]
+		return ok && rowIdx+1 < anchor.row+s.rows
+	}
+}
+
+// span is a cell covering the displayed columns from position first to
+// last, with the separators between them
+type span struct {
+	first int
+	last  int
+	text  string
+	style lipgloss.Style
+	align lipgloss.Position
+}
+
+// spanWidth returns the width covered by the displayed columns from
+// position first to last, including the separators between them
+func (m Model) spanWidth(first, last int) int {
+	return m.columnStart(last) + m.columnWidths[m.columnOrder[last]] - m.columnStart(first)
+}
+
+// renderSpans renders a line of spans that together cover every displayed
+// column in order, scrolled like the rest of the table. Separators are only
+// drawn between spans.
+func (m Model) renderSpans(spans []span) string {
+	owner := make([]int, len(m.columnOrder))
+	texts := make([]string, len(spans))
+	for i, s := range spans {
+		for pos := s.first; pos <= s.last; pos++ {
+			owner[pos] = i
+		}
+
+		width := m.spanWidth(s.first, s.last)
+		texts[i] = alignCell(truncate(s.text, max(width-2, 0), TruncateEnd, m.ellipsis), width, s.align)
+	}
+
+	// cut renders the part of a span's text at [start, end) of the column at pos
+	cut := func(pos, start, end int) string {
+		i := owner[pos]
+		offset := m.columnStart(pos) - m.columnStart(spans[i].first)
+		return spans[i].style.Render(ansi.Cut(texts[i], offset+start, offset+end))
+	}
+
+	var line strings.Builder
+	m.walkColumns(func(colIdx, start, end int) {
+		line.WriteString(cut(m.viewIndex(colIdx), start, end))
+	}, func(colIdx int) {
+		// Inside a span the separator's place is filled with its text
+		if pos := m.viewIndex(colIdx); owner[pos] == owner[pos-1] {
+			line.WriteString(cut(pos, -1, 0))
+		} else {
+			line.WriteString(m.theme.Border.Render(m.border.Left))
+		}
+	})
+
+	result := m.renderGutter(-1, 0, true) + line.String()
+	if m.showFrame {
+		result = m.theme.Border.Render(m.border.Left) + result + m.theme.Border.Render(m.border.Right)
+	}
+
+	return result
+}
+
+// spanStarts returns a function reporting whether a displayed column starts
+// one of spans, which is where the line has a separator before it
+func (m Model) spanStarts(spans []span) func(colIdx int) bool {
+	return func(colIdx int) bool {
+		pos := m.viewIndex(colIdx)
+		for _, s := range spans {
+			if s.first == pos {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// separated reports that every column has a separator before it, as on
+// lines without spans
+func separated(int) bool {
+	return true
+}
+
+// renderDivider draws a horizontal border whose junctions join the column
+// separators of the lines above and below it, which report whether they
+// have a separator before a column and are nil at the top or bottom of the
+// table. open reports columns the border leaves blank because a merged cell
+// runs through them; nil crosses every column.
+func (m Model) renderDivider(b lipgloss.Border, left, fill, right string, above, below, open func(colIdx int) bool) string {
+	crossed := func(colIdx int) bool {
+		return open == nil || !open(colIdx)
+	}
+	up := func(colIdx int) bool {
+		return above != nil && above(colIdx)
+	}
+	down := func(colIdx int) bool {
+		return below != nil && below(colIdx)
+	}
+
+	var result strings.Builder
+	firstCol, lastCol := -1, -1
+
+	m.walkColumns(func(colIdx, start, end int) {
+		if firstCol < 0 {
+			firstCol = colIdx
+		}
+		lastCol = colIdx
+
+		if crossed(colIdx) {
+			result.WriteString(strings.Repeat(fill, end-start))
+		} else {
+			result.WriteString(strings.Repeat(" ", end-start))
+		}
+	}, func(colIdx int) {
+		prev := m.columnOrder[m.viewIndex(colIdx)-1]
+		result.WriteString(m.junction(b, fill, up(colIdx), down(colIdx), crossed(prev), crossed(colIdx)))
+	})
+
+	line := result.String()
+
+	// The gutter is always crossed and always has a separator
+	gutter := ""
+	if width := m.gutterWidth(); width > 0 {
+		if m.showColumnSeparators {
+			width--
+		}
+		gutter = strings.Repeat(fill, width)
+
+		if m.showColumnSeparators {
+			gutter += m.junction(b, fill, above != nil, below != nil, true, firstCol < 0 || crossed(firstCol))
+		}
+	}
+	line = gutter + line
+
+	if m.showFrame {
+		// A merged cell at the edge keeps the frame's side unbroken
+		if gutter == "" && firstCol >= 0 && !crossed(firstCol) {
+			left = m.border.Left
+		}
+		if lastCol >= 0 && !crossed(lastCol) {
+			right = m.border.Right
+		}
+		line = left + line + right
+	}
+
+	return m.theme.Border.Render(line)
+}
+
+// junction returns the border character where a column separator meets a
+// horizontal border, given which of its four arms are drawn
+func (m Model) junction(b lipgloss.Border, fill string, up, down, left, right bool) string {
+	switch {
+	case up && down:
+		switch {
+		case left && right:
+			return b.Middle
+		case left:
+			return b.MiddleRight
+		case right:
+			return b.MiddleLeft
+		}
+		return m.border.Left
+	case up:
+		switch {
+		case left && right:
+			return b.MiddleBottom
+		case left:
+			return b.BottomRight
+		case right:
+			return b.BottomLeft
+		}
+		return m.border.Left
+	case down:
+		switch {
+		case left && right:
+			return b.MiddleTop
+		case left:
+			return b.TopRight
+		case right:
+			return b.TopLeft
+		}
+		return m.border.Left
+	case left || right:
+		return fill
+	}
+
+	return " "
+}
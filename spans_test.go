@@ -0,0 +1,36 @@
+package table
+
+import "testing"
+
+func TestSetCellSpanIgnoresCellsOutOfRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		row, col  int
+		cols      int
+		rows      int
+		wantSpans int
+	}{
+		{"in range", 0, 0, 2, 2, 1},
+		{"negative row", -1, 0, 1, 3, 0},
+		{"row past the end", 3, 0, 2, 1, 0},
+		{"negative column", 0, -1, 2, 1, 0},
+		{"column past the end", 0, 3, 2, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"A", "B", "C"})
+			m.SetRows([][]string{{"a", "b", "c"}, {"d", "e", "f"}, {"g", "h", "i"}})
+			m.SetSize(40, 10)
+
+			m.SetCellSpan(tt.row, tt.col, tt.cols, tt.rows)
+			if got := len(m.cellSpans); got != tt.wantSpans {
+				t.Errorf("%d spans stored, want %d", got, tt.wantSpans)
+			}
+
+			// Must not panic
+			_ = m.View()
+		})
+	}
+}
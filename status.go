@@ -0,0 +1,91 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Status describes the position in the table and its state, as shown on
+// the status line
+type Status struct {
+	Row    int    // Position of the selected row among the data rows, from 1; 0 when nothing is selected
+	Rows   int    // Number of data rows, not counting section and disabled rows
+	Col    int    // Position of the selected column among the displayed columns, from 1
+	Cols   int    // Number of displayed columns
+	Hidden int    // Number of columns hidden by the user or to fit the width
+	Search string // Search progress, as returned by SearchStatus
+	Record bool   // The table shows the selected row as a record
+}
+
+// ShowStatus sets whether a status line below the table shows the selected
+// row and column and the state of the table
+func (m *Model) ShowStatus(show bool) {
+	m.showStatus = show
+	m.ensureVisible()
+}
+
+// Status returns the data shown on the status line, for hosts rendering
+// their own status bar
+func (m Model) Status() Status {
+	status := Status{
+		Rows:   m.DataRowCount(),
+		Cols:   len(m.columnOrder),
+		Hidden: len(m.displayOrder) - len(m.columnOrder),
+		Search: m.SearchStatus(),
+		Record: m.recordView,
+	}
+
+	if m.isSelectable(m.selectedRow) {
+		for row := 0; row <= m.selectedRow; row++ {
+			if m.isSelectable(row) {
+				status.Row++
+			}
+		}
+	}
+	if pos := m.viewIndex(m.selectedCol); pos >= 0 {
+		status.Col = pos + 1
+	}
+
+	return status
+}
+
+// String formats the status as "Row 12/50 · Col 3/18", followed by the
+// hidden columns and search progress when there are any
+func (s Status) String() string {
+	parts := []string{
+		fmt.Sprintf("Row %d/%d", s.Row, s.Rows),
+		fmt.Sprintf("Col %d/%d", s.Col, s.Cols),
+	}
+	if s.Hidden > 0 {
+		parts = append(parts, fmt.Sprintf("%d hidden", s.Hidden))
+	}
+	if s.Search != "" {
+		parts = append(parts, "search: "+s.Search)
+	}
+	if s.Record {
+		parts = append(parts, "record view")
+	}
+
+	return strings.Join(parts, " · ")
+}
+
+// statusHeight returns the height taken by the status line
+func (m Model) statusHeight() int {
+	if m.showStatus {
+		return 1
+	}
+	return 0
+}
+
+// renderStatus renders the status line across the width of the table
+func (m Model) renderStatus() string {
+	text := " " + m.Status().String() + " "
+	if m.width > 0 {
+		text = ansi.Truncate(text, m.width, m.ellipsis)
+		text += strings.Repeat(" ", max(m.width-ansi.StringWidth(text), 0))
+	}
+
+	return m.theme.Status.Render(text)
+}
@@ -1,11 +1,15 @@
 package table
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // SelectionMode defines how selections work (can be combined with bitwise OR)
@@ -24,6 +28,39 @@ type Theme struct {
 	Border       lipgloss.Style
 	SelectedRow  lipgloss.Style
 	SelectedCell lipgloss.Style
+	Match        lipgloss.Style
+
+	// BlurredRow and BlurredCell replace SelectedRow and SelectedCell
+	// while the table is blurred
+	BlurredRow  lipgloss.Style
+	BlurredCell lipgloss.Style
+
+	// AltCell is layered over Cell on every other row for zebra striping.
+	// AltRow fills in unset properties, typically a background, of custom
+	// row and column styles on those rows so the stripes show through.
+	AltCell lipgloss.Style
+	AltRow  lipgloss.Style
+
+	// Popup frames the cell detail popup
+	Popup lipgloss.Style
+
+	// Indicator styles the count of columns hidden to fit the width
+	Indicator lipgloss.Style
+
+	// Section styles section rows and Disabled styles disabled rows
+	Section  lipgloss.Style
+	Disabled lipgloss.Style
+
+	// Gutter styles the row numbers and markers left of the columns
+	Gutter lipgloss.Style
+
+	// ScrollTrack styles the scrollbar tracks and ScrollThumb their thumbs
+	// and the scroll arrows
+	ScrollTrack lipgloss.Style
+	ScrollThumb lipgloss.Style
+
+	// Status styles the status line below the table
+	Status lipgloss.Style
 }
 
 func DefaultTheme() Theme {
@@ -43,9 +80,53 @@ func DefaultTheme() Theme {
 			Bold(true).
 			Foreground(lipgloss.Color("230")).
 			Background(lipgloss.Color("129")),
+		BlurredRow: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("252")).
+			Background(lipgloss.Color("237")),
+		BlurredCell: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("252")).
+			Background(lipgloss.Color("240")),
+		Match: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("16")).
+			Background(lipgloss.Color("214")),
+		Popup: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("63")).
+			Padding(0, 1),
+		Indicator: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("230")).
+			Background(lipgloss.Color("240")),
+		Section: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("63")),
+		Disabled: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")).
+			Faint(true),
+		Gutter: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("243")),
+		ScrollTrack: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("238")),
+		ScrollThumb: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("63")),
+		Status: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("252")).
+			Background(lipgloss.Color("236")),
 	}
 }
 
+// CellState describes a cell being rendered, for use by a StyleFunc
+type CellState struct {
+	Header   bool // The cell is a header
+	Selected bool // The cell is highlighted by the current selection mode
+	Matched  bool // The cell matches the current search
+	Focused  bool // The table has focus
+}
+
+// StyleFunc returns a style for a cell. Properties it sets override the
+// default style; unset properties fall through to it. row is -1 for headers.
+type StyleFunc func(row, col int, value string, state CellState) lipgloss.Style
+
 type KeyMap struct {
 	Up       key.Binding
 	Down     key.Binding
@@ -55,6 +136,24 @@ type KeyMap struct {
 	End      key.Binding
 	PageUp   key.Binding
 	PageDown key.Binding
+
+	Search       key.Binding
+	NextMatch    key.Binding
+	PrevMatch    key.Binding
+	AcceptSearch key.Binding
+	CancelSearch key.Binding
+
+	ShowDetail  key.Binding
+	CloseDetail key.Binding
+
+	ToggleRecord key.Binding
+
+	HideColumn      key.Binding
+	ShowColumns     key.Binding
+	RestoreColumn   key.Binding
+	ClosePicker     key.Binding
+	MoveColumnLeft  key.Binding
+	MoveColumnRight key.Binding
 }
 
 func DefaultKeyMap() KeyMap {
@@ -91,6 +190,62 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("pgdown", "ctrl+d"),
 			key.WithHelp("PgDn/ctrl+d", "scroll down"),
 		),
+		Search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+		NextMatch: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "next match"),
+		),
+		PrevMatch: key.NewBinding(
+			key.WithKeys("N"),
+			key.WithHelp("N", "previous match"),
+		),
+		AcceptSearch: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply search"),
+		),
+		CancelSearch: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel search"),
+		),
+		ShowDetail: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "view cell"),
+		),
+		CloseDetail: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close cell view"),
+		),
+		ToggleRecord: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "toggle record view"),
+		),
+		HideColumn: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "hide column"),
+		),
+		ShowColumns: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "hidden columns"),
+		),
+		RestoreColumn: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "show column"),
+		),
+		ClosePicker: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close"),
+		),
+		MoveColumnLeft: key.NewBinding(
+			key.WithKeys("<"),
+			key.WithHelp("<", "move column left"),
+		),
+		MoveColumnRight: key.NewBinding(
+			key.WithKeys(">"),
+			key.WithHelp(">", "move column right"),
+		),
 	}
 }
 
@@ -99,13 +254,25 @@ type Model struct {
 	// Data
 	headers []string
 	rows    [][]string
+	values  [][]any // Raw values when set with SetValues, nil otherwise
 
 	// Dimensions
 	width  int
 	height int
 
 	// Column widths
-	columnWidths []int
+	columnWidths  []int // Indexed by data column
+	columnOrder   []int // Data column of each displayed column, in display order
+	displayOrder  []int // Every data column in the order arranged by the user
+	hiddenColumns map[int]bool
+	layout        LayoutStrategy
+	minWidths     map[int]int // Content widths set with SetColumnMinWidth
+	maxWidths     map[int]int // Content widths set with SetColumnMaxWidth
+	widthSampling WidthSampling
+	overflow      Overflow
+	priorities    map[int]int
+	fixedWidths   map[int]int // Content widths set with SetColumnWidth
+	columnAligns  map[int]lipgloss.Position
 
 	// Scrolling
 	offsetX int // Horizontal scroll offset
@@ -120,30 +287,118 @@ type Model struct {
 	theme        Theme
 	columnStyles map[int]lipgloss.Style
 	rowStyles    map[int]lipgloss.Style
+	rules        []Rule
+	styleFunc    StyleFunc
+
+	// Custom cell content
+	columnRenderers  map[int]CellRenderer
+	columnFormatters map[int]Formatter
+	wrapColumns      map[int]bool
+	maxRowHeight     int // Maximum lines per row, 0 for no limit
+	columnTruncation map[int]Truncation
+	overflowing      bool // Some column overflows instead of truncating
+	ellipsis         string
+
+	// Merged body cells by their first cell
+	cellSpans map[cellPos]cellSpan
+
+	// Section and disabled rows; other rows hold data
+	rowKinds map[int]RowKind
+
+	// Gutter left of the columns
+	rowNumbers RowNumbers
+	rowMarkers map[int]string
+
+	// Row heights, cached until the layout changes
+	heights       *rowHeights
+	layoutVersion int64
 
 	// Options
-	showHeaders bool
-	showBorders bool
+	showHeaders  bool
+	columnGroups [][]ColumnGroup // Header bands by level, from the top down
+
+	// Borders
+	border               lipgloss.Border
+	headerBorder         lipgloss.Border
+	showFrame            bool
+	showHeaderSeparator  bool
+	showColumnSeparators bool
+	showRowSeparators    bool
+
+	// Scrollbars
+	showVerticalScrollbar   bool
+	showHorizontalScrollbar bool
+	showScrollArrows        bool
+
+	// Status line below the table
+	showStatus bool
+
+	// Search
+	searchInput   textinput.Model
+	searching     bool // Search input is active
+	searchQuery   string
+	searchMatches []cellPos // Matching cells in display order
+	searchIndex   int       // Index of the current match, -1 if none
+	searchWrapped bool      // Last jump wrapped around the table
+	searchOrigin  cellPos   // Selection when the search input was opened
+
+	// Cell detail popup
+	showDetail bool
+	detail     viewport.Model
+
+	// Record view shows the selected row as a vertical form
+	recordView bool
+
+	// Picker listing hidden columns to show again
+	showPicker  bool
+	pickerIndex int
 
 	// Keymap
 	keyMap KeyMap
+	focus  bool // Keys are handled only while focused
 }
 
 // New creates a new table model
 func New() Model {
+	searchInput := textinput.New()
+	searchInput.Prompt = "/"
+
 	return Model{
-		headers:       []string{},
-		rows:          [][]string{},
-		columnWidths:  []int{},
-		selectedRow:   0,
-		selectedCol:   0,
-		selectionMode: SelectionRow,
-		showHeaders:   true,
-		showBorders:   true,
-		columnStyles:  make(map[int]lipgloss.Style),
-		rowStyles:     make(map[int]lipgloss.Style),
-		theme:         DefaultTheme(),
-		keyMap:        DefaultKeyMap(),
+		headers:              []string{},
+		rows:                 [][]string{},
+		columnWidths:         []int{},
+		fixedWidths:          make(map[int]int),
+		hiddenColumns:        make(map[int]bool),
+		cellSpans:            make(map[cellPos]cellSpan),
+		rowKinds:             make(map[int]RowKind),
+		rowMarkers:           make(map[int]string),
+		heights:              &rowHeights{heights: make(map[int]int)},
+		layout:               FitContentLayout{},
+		minWidths:            make(map[int]int),
+		maxWidths:            make(map[int]int),
+		priorities:           make(map[int]int),
+		columnAligns:         make(map[int]lipgloss.Position),
+		selectedRow:          0,
+		selectedCol:          0,
+		selectionMode:        SelectionRow,
+		showHeaders:          true,
+		border:               lipgloss.NormalBorder(),
+		headerBorder:         lipgloss.NormalBorder(),
+		showHeaderSeparator:  true,
+		showColumnSeparators: true,
+		showRowSeparators:    true,
+		columnStyles:         make(map[int]lipgloss.Style),
+		rowStyles:            make(map[int]lipgloss.Style),
+		columnRenderers:      make(map[int]CellRenderer),
+		columnFormatters:     make(map[int]Formatter),
+		wrapColumns:          make(map[int]bool),
+		columnTruncation:     make(map[int]Truncation),
+		ellipsis:             "…",
+		theme:                DefaultTheme(),
+		keyMap:               DefaultKeyMap(),
+		focus:                true,
+		searchInput:          searchInput,
+		searchIndex:          -1,
 	}
 }
 
@@ -162,14 +417,19 @@ func (m *Model) ShowHeaders(show bool) {
 	m.showHeaders = show
 }
 
-// ShowBorders sets whether to show borders between cells
+// ShowBorders sets whether to show borders between cells. It toggles the
+// header separator, column separators and row separators together.
 func (m *Model) ShowBorders(show bool) {
-	m.showBorders = show
+	m.showHeaderSeparator = show
+	m.showColumnSeparators = show
+	m.showRowSeparators = show
+	m.calculateColumnWidths()
 }
 
 // SetHeaders sets the table headers
 func (m *Model) SetHeaders(headers []string) {
 	m.headers = headers
+	m.invalidateHeights()
 	if m.width > 0 {
 		m.calculateColumnWidths()
 	}
@@ -177,10 +437,23 @@ func (m *Model) SetHeaders(headers []string) {
 
 // SetRows sets the table rows
 func (m *Model) SetRows(rows [][]string) {
+	m.values = nil
+	clear(m.rowKinds)
+	m.setRows(rows)
+}
+
+// setRows sets the displayed rows and refreshes everything derived from them
+func (m *Model) setRows(rows [][]string) {
 	m.rows = rows
+	m.invalidateHeights()
 	if m.width > 0 {
 		m.calculateColumnWidths()
 	}
+	m.selectedRow = m.selectableRow(m.selectedRow, 1)
+	if m.searchQuery != "" {
+		m.updateSearchMatches()
+		m.resetSearchPosition()
+	}
 }
 
 // SetSize sets the viewport size
@@ -191,6 +464,9 @@ func (m *Model) SetSize(width, height int) {
 	if len(m.headers) > 0 || len(m.rows) > 0 {
 		m.calculateColumnWidths()
 	}
+	if m.showDetail {
+		m.layoutDetail()
+	}
 }
 
 // SetSelectionMode sets how selection works
@@ -222,6 +498,12 @@ func (m *Model) HasSelectionMode(mode SelectionMode) bool {
 	return m.selectionMode&mode != 0
 }
 
+// columnSelection reports whether a column is selected, which the keys
+// that hide and move the selected column need
+func (m Model) columnSelection() bool {
+	return m.HasSelectionMode(SelectionColumn) || m.HasSelectionMode(SelectionCell)
+}
+
 // SetColumnStyle sets a custom style for a specific column
 func (m *Model) SetColumnStyle(col int, style lipgloss.Style) {
 	m.columnStyles[col] = style
@@ -232,8 +514,34 @@ func (m *Model) SetRowStyle(row int, style lipgloss.Style) {
 	m.rowStyles[row] = style
 }
 
+// SetColumnWidth fixes the content width of a column, excluding padding.
+// Fixed columns are not expanded to fill the table. Pass 0 to size the
+// column to its content again.
+func (m *Model) SetColumnWidth(col, width int) {
+	if width <= 0 {
+		delete(m.fixedWidths, col)
+	} else {
+		m.fixedWidths[col] = width
+	}
+
+	m.calculateColumnWidths()
+}
+
+// SetColumnAlign sets the horizontal alignment of a column's cells and header
+func (m *Model) SetColumnAlign(col int, align lipgloss.Position) {
+	m.columnAligns[col] = align
+}
+
+// SetStyleFunc sets a function to style individual cells. It is called
+// after the default style has been computed. Pass nil to remove it.
+func (m *Model) SetStyleFunc(fn StyleFunc) {
+	m.styleFunc = fn
+}
+
 // calculateColumnWidths calculates the width of each column
 func (m *Model) calculateColumnWidths() {
+	m.invalidateHeights()
+
 	if len(m.headers) == 0 && len(m.rows) == 0 {
 		return
 	}
@@ -252,17 +560,26 @@ func (m *Model) calculateColumnWidths() {
 
 	// Check header widths
 	for i, header := range m.headers {
-		if i < numCols && len(header) > m.columnWidths[i] {
-			m.columnWidths[i] = len(header)
+		if i < numCols {
+			m.columnWidths[i] = max(m.columnWidths[i], ansi.StringWidth(header))
+		}
+	}
+
+	// Columns with a renderer take the width it needs
+	for i, renderer := range m.columnRenderers {
+		if i < numCols {
+			m.columnWidths[i] = max(m.columnWidths[i], renderer.MinWidth())
 		}
 	}
 
 	// Check row widths and find the widest cell in each column
-	for _, row := range m.rows {
-		for i, cell := range row {
-			if i < numCols && len(cell) > m.columnWidths[i] {
-				m.columnWidths[i] = len(cell)
-			}
+	m.measureRows(numCols)
+	m.limitWidths()
+
+	// Fixed widths replace the measured content width
+	for i, w := range m.fixedWidths {
+		if i < numCols {
+			m.columnWidths[i] = w
 		}
 	}
 
@@ -271,52 +588,74 @@ func (m *Model) calculateColumnWidths() {
 		m.columnWidths[i] += 2
 	}
 
+	// Display columns in the user's order, leaving out hidden ones
+	m.displayOrder = normalizeOrder(m.displayOrder, numCols)
+	m.columnOrder = slices.DeleteFunc(slices.Clone(m.displayOrder), func(colIdx int) bool {
+		return m.hiddenColumns[colIdx]
+	})
+	defer m.clampSelectedColumn()
+
 	// Don't expand if width is not set
-	if m.width <= 0 {
+	width := m.contentWidth()
+	if width <= 0 {
 		return
 	}
 
-	// Calculate total content width
-	totalContentWidth := 0
-	for _, w := range m.columnWidths {
-		totalContentWidth += w
+	// Hide columns to fit, leaving room for the indicator in the last column
+	reserve := 0
+	if m.overflow == OverflowHide && m.displayedWidth() > width {
+		reserve = lipgloss.Width(m.theme.Indicator.Render(hiddenLabel(numCols)))
+		m.hideColumnsByPriority(width - reserve)
 	}
 
-	// Add space for borders if enabled
-	if m.showBorders && numCols > 1 {
-		totalContentWidth += (numCols - 1)
+	// Lay out the displayed columns in the width left by the separators
+	columns := make([]LayoutColumn, len(m.columnOrder))
+	for i, colIdx := range m.columnOrder {
+		_, fixed := m.fixedWidths[colIdx]
+		columns[i] = LayoutColumn{Index: colIdx, Natural: m.columnWidths[colIdx], Fixed: fixed}
+		if w, ok := m.minWidths[colIdx]; ok && !fixed {
+			columns[i].Min = w + 2
+		}
+		if w, ok := m.maxWidths[colIdx]; ok && !fixed {
+			columns[i].Max = w + 2
+		}
 	}
 
-	// If table width is set and content is narrower, expand columns proportionally
-	if totalContentWidth < m.width {
-		availableExtra := m.width - totalContentWidth
+	available := width - reserve
+	if m.showColumnSeparators && len(columns) > 1 {
+		available -= len(columns) - 1
+	}
 
-		// Calculate total weight (sum of current widths)
-		totalWeight := 0
-		for _, w := range m.columnWidths {
-			totalWeight += w
+	widths := m.layout.Layout(columns, available)
+	for i, col := range columns {
+		if i < len(widths) {
+			// Keep a cell of content so no column collapses to nothing
+			m.columnWidths[col.Index] = col.clamp(max(widths[i], min(col.Natural, 3)))
 		}
+	}
 
-		if totalWeight > 0 {
-			// Distribute extra space proportionally to current widths
-			distributed := 0
-			for i := range m.columnWidths {
-				if i < len(m.columnWidths)-1 {
-					// Calculate proportional extra space for this column
-					extra := (m.columnWidths[i] * availableExtra) / totalWeight
-					m.columnWidths[i] += extra
-					distributed += extra
-				} else {
-					// Last column gets the remaining space to avoid rounding errors
-					m.columnWidths[i] += (availableExtra - distributed)
-				}
-			}
-		}
+	// The indicator is drawn over the padding of the last displayed column
+	if reserve > 0 {
+		m.columnWidths[m.columnOrder[len(m.columnOrder)-1]] += reserve
 	}
 }
 
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if _, ok := msg.(tea.KeyMsg); ok && !m.focus {
+		return m, nil
+	}
+
+	if m.searching {
+		return m.updateSearch(msg)
+	}
+	if m.showDetail {
+		return m.updateDetail(msg)
+	}
+	if m.showPicker {
+		return m.updatePicker(msg)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch {
@@ -329,15 +668,33 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, m.keyMap.Right):
 			m.moveSelection(0, 1)
 		case key.Matches(msg, m.keyMap.Home):
-			m.selectedRow = 0
+			m.selectedRow = m.selectableRow(0, 1)
 			m.ensureVisible()
 		case key.Matches(msg, m.keyMap.End):
-			m.selectedRow = len(m.rows) - 1
+			m.selectedRow = max(m.selectableRow(len(m.rows)-1, -1), 0)
 			m.ensureVisible()
 		case key.Matches(msg, m.keyMap.PageUp):
 			m.moveSelection(-10, 0)
 		case key.Matches(msg, m.keyMap.PageDown):
 			m.moveSelection(10, 0)
+		case key.Matches(msg, m.keyMap.Search):
+			return m, m.StartSearch()
+		case key.Matches(msg, m.keyMap.NextMatch):
+			m.NextMatch()
+		case key.Matches(msg, m.keyMap.PrevMatch):
+			m.PrevMatch()
+		case key.Matches(msg, m.keyMap.ShowDetail):
+			m.OpenDetail()
+		case key.Matches(msg, m.keyMap.ToggleRecord):
+			m.ToggleRecordView()
+		case key.Matches(msg, m.keyMap.HideColumn) && m.columnSelection():
+			m.HideColumn(m.selectedCol)
+		case key.Matches(msg, m.keyMap.ShowColumns):
+			m.OpenColumnPicker()
+		case key.Matches(msg, m.keyMap.MoveColumnLeft) && m.columnSelection():
+			m.MoveColumn(m.selectedCol, -1)
+		case key.Matches(msg, m.keyMap.MoveColumnRight) && m.columnSelection():
+			m.MoveColumn(m.selectedCol, 1)
 		}
 	}
 
@@ -352,6 +709,11 @@ func (m *Model) moveSelection(rowDelta, colDelta int) {
 
 	// Always update position based on the delta
 	if rowDelta != 0 {
+		// Leave a merged cell from its last row when moving down
+		if span, ok := m.cellSpans[cellPos{m.selectedRow, m.selectedCol}]; ok && rowDelta > 0 {
+			m.selectedRow += span.rows - 1
+		}
+
 		m.selectedRow += rowDelta
 		if m.selectedRow < 0 {
 			m.selectedRow = 0
@@ -359,63 +721,68 @@ func (m *Model) moveSelection(rowDelta, colDelta int) {
 		if m.selectedRow >= len(m.rows) {
 			m.selectedRow = len(m.rows) - 1
 		}
-	}
 
-	if colDelta != 0 {
-		m.selectedCol += colDelta
-		if m.selectedCol < 0 {
-			m.selectedCol = 0
+		// Skip section and disabled rows in the direction of travel
+		if rowDelta > 0 {
+			m.selectedRow = m.selectableRow(m.selectedRow, 1)
+		} else {
+			m.selectedRow = m.selectableRow(m.selectedRow, -1)
 		}
-		if m.selectedCol >= len(m.headers) {
-			m.selectedCol = len(m.headers) - 1
+	}
+
+	// Columns move in display order, skipping hidden ones
+	if colDelta != 0 && len(m.columnOrder) > 0 {
+		pos := m.viewIndex(m.selectedCol)
+
+		// Leave a merged cell from its last column when moving right
+		if pos >= 0 && colDelta > 0 {
+			_, _, pos = m.spanAt(m.selectedRow, pos)
 		}
+
+		pos = max(0, min(pos+colDelta, len(m.columnOrder)-1))
+		m.selectedCol = m.columnOrder[pos]
 	}
 
+	// Merged cells are selected through their first cell
+	m.selectedRow, m.selectedCol = m.spanAnchor(m.selectedRow, m.selectedCol)
+
 	m.ensureVisible()
 }
 
 // ensureVisible ensures the selected cell is visible
 func (m *Model) ensureVisible() {
-	// Calculate visible rows
-	visibleRows := m.height
-	if m.showHeaders {
-		visibleRows -= 1
-		if m.showBorders {
-			visibleRows -= 1
-		}
-	}
-	if m.showBorders && visibleRows > 1 {
-		// Account for borders between rows
-		visibleRows = (visibleRows + 1) / 2
+	// Nothing to scroll until the viewport has a size
+	if m.width <= 0 || m.height <= 0 {
+		return
 	}
 
 	// Vertical scrolling
 	if m.selectedRow < m.offsetY {
 		m.offsetY = m.selectedRow
-	} else if m.selectedRow >= m.offsetY+visibleRows {
-		m.offsetY = m.selectedRow - visibleRows + 1
+	} else if m.selectedRow < len(m.rows) && m.selectedRow >= m.offsetY+m.visibleRowCount() {
+		m.offsetY = m.firstRowShowing(m.selectedRow)
 	}
 
 	// Horizontal scrolling
-	// Calculate total width needed up to selected column
-	totalWidth := 0
-	for i := 0; i <= m.selectedCol && i < len(m.columnWidths); i++ {
-		totalWidth += m.columnWidths[i]
-		if m.showBorders && i > 0 {
-			totalWidth += 1 // Border between columns
-		}
+	pos := m.viewIndex(m.selectedCol)
+	if pos < 0 {
+		return
 	}
 
+	// Calculate total width needed up to selected column
+	columnStart := m.columnStart(pos)
+	totalWidth := columnStart + m.columnWidths[m.selectedCol]
+
 	// Adjust horizontal offset
-	if totalWidth-m.columnWidths[m.selectedCol] < m.offsetX {
+	if columnStart < m.offsetX {
 		// Selected column is too far left
-		m.offsetX = totalWidth - m.columnWidths[m.selectedCol]
-		if m.selectedCol > 0 && m.showBorders {
+		m.offsetX = columnStart
+		if pos > 0 && m.showColumnSeparators {
 			m.offsetX -= 1
 		}
-	} else if totalWidth > m.offsetX+m.width {
+	} else if totalWidth > m.offsetX+m.contentWidth() {
 		// Selected column is too far right
-		m.offsetX = totalWidth - m.width
+		m.offsetX = totalWidth - m.contentWidth()
 	}
 
 	if m.offsetX < 0 {
@@ -425,176 +792,403 @@ func (m *Model) ensureVisible() {
 
 // View renders the table
 func (m Model) View() string {
+	// The grid shows again once no row can be selected
+	var lines []string
+	if m.recordView && m.isSelectable(m.selectedRow) {
+		lines = m.recordLines()
+	} else {
+		lines = m.tableLines()
+	}
+
+	// Render the search input below the rows
+	if m.searching {
+		lines = append(lines, m.searchInput.View())
+	}
+	if m.showStatus {
+		lines = append(lines, m.renderStatus())
+	}
+
+	view := strings.Join(lines, "\n")
+	if m.showDetail {
+		view = m.renderDetail(view)
+	}
+	if m.showPicker {
+		view = m.renderPicker(view)
+	}
+
+	return view
+}
+
+// tableLines renders the grid: frame, headers and the visible rows
+func (m Model) tableLines() []string {
 	var lines []string
 
+	if m.showFrame {
+		// Join the top border to the separators of the first line
+		below := separated
+		if m.showHeaders && len(m.columnGroups) > 0 {
+			below = m.spanStarts(m.groupSpans(0))
+		} else if !m.showHeaders && m.offsetY < len(m.rows) {
+			below = m.rowStarts(m.offsetY)
+		}
+
+		b := m.border
+		lines = append(lines, m.renderDivider(b, b.TopLeft, b.Top, b.TopRight, nil, below, nil))
+	}
+
+	// Render column groups above the headers
+	if m.showHeaders {
+		for level := range m.columnGroups {
+			spans := m.groupSpans(level)
+			lines = append(lines, m.renderSpans(spans))
+
+			if m.showHeaderSeparator {
+				above := m.spanStarts(spans)
+				below := separated
+				if level+1 < len(m.columnGroups) {
+					below = m.spanStarts(m.groupSpans(level + 1))
+				}
+
+				b := m.border
+				lines = append(lines, m.renderDivider(b, b.MiddleLeft, b.Top, b.MiddleRight, above, below, nil))
+			}
+		}
+	}
+
 	// Render headers
+	arrowLine := -1
 	if m.showHeaders {
+		arrowLine = len(lines)
 		headerLine := m.renderRow(m.headers, -1, true)
-		lines = append(lines, headerLine)
+		lines = append(lines, strings.Split(headerLine, "\n")...)
+
+		if m.showHeaderSeparator {
+			below := separated
+			if m.offsetY < len(m.rows) {
+				below = m.rowStarts(m.offsetY)
+			}
 
-		if m.showBorders {
-			lines = append(lines, m.renderBorder())
+			b := m.headerBorder
+			lines = append(lines, m.renderDivider(b, b.MiddleLeft, b.Top, b.MiddleRight, separated, below, nil))
 		}
 	}
 
-	// Calculate visible rows
-	availableHeight := m.height - len(lines)
-	visibleRows := availableHeight
-	if m.showBorders && visibleRows > 1 {
-		visibleRows = (visibleRows + 1) / 2
+	// Render visible rows
+	bodyStart := len(lines)
+	if arrowLine < 0 && m.offsetY < len(m.rows) {
+		arrowLine = bodyStart
 	}
 
-	// Render visible rows
+	above := separated
+	visibleRows := m.visibleRowCount()
 	for i := 0; i < visibleRows && m.offsetY+i < len(m.rows); i++ {
 		rowIdx := m.offsetY + i
 		rowLine := m.renderRow(m.rows[rowIdx], rowIdx, false)
-		lines = append(lines, rowLine)
-
-		// Add border between rows
-		if m.showBorders && i < visibleRows-1 && rowIdx < len(m.rows)-1 {
-			lines = append(lines, m.renderBorder())
+		lines = append(lines, strings.Split(rowLine, "\n")...)
+		above = m.rowStarts(rowIdx)
+
+		// Add border between rows, left open where a merged cell runs through
+		if m.showRowSeparators && i < visibleRows-1 && rowIdx < len(m.rows)-1 {
+			b := m.border
+			lines = append(lines, m.renderDivider(b, b.MiddleLeft, b.Top, b.MiddleRight,
+				above, m.rowStarts(rowIdx+1), m.rowContinues(rowIdx)))
 		}
 	}
 
-	return strings.Join(lines, "\n")
+	bodyEnd := len(lines)
+
+	if m.showFrame {
+		b := m.border
+		lines = append(lines, m.renderDivider(b, b.BottomLeft, b.Bottom, b.BottomRight, above, nil, nil))
+	}
+
+	if len(lines) > 0 {
+		lines[0] = m.hiddenIndicator(lines[0])
+	}
+
+	return m.renderScrollbars(lines, arrowLine, bodyStart, bodyEnd)
 }
 
-// renderRow renders a single row with proper horizontal scrolling
-func (m Model) renderRow(row []string, rowIdx int, isHeader bool) string {
-	var result strings.Builder
+// visibleRowCount returns how many rows fit in the viewport from the
+// current vertical offset
+func (m Model) visibleRowCount() int {
+	return m.rowsFitting(m.offsetY)
+}
+
+// walkColumns visits the columns inside the viewport from left to right.
+// cell receives the visible range [start, end) of each column and separator
+// is called for every visible separator with the index of the column after it.
+func (m Model) walkColumns(cell func(colIdx, start, end int), separator func(colIdx int)) {
+	viewStart := m.offsetX
+	viewEnd := m.offsetX + m.contentWidth()
 	currentPos := 0
 
-	for colIdx := 0; colIdx < len(row) && colIdx < len(m.columnWidths); colIdx++ {
+	for pos, colIdx := range m.columnOrder {
 		colWidth := m.columnWidths[colIdx]
 
 		// Add border before column (except first)
-		if colIdx > 0 && m.showBorders {
-			if currentPos >= m.offsetX {
-				result.WriteString(m.theme.Border.Render("│"))
+		if pos > 0 && m.showColumnSeparators {
+			if currentPos >= viewStart && currentPos < viewEnd {
+				separator(colIdx)
 			}
 			currentPos++
 		}
 
-		// Skip columns that are completely before the viewport
-		if currentPos+colWidth <= m.offsetX {
-			currentPos += colWidth
-			continue
-		}
-
 		// Stop if we've gone past the viewport
-		if currentPos >= m.offsetX+m.width {
+		if currentPos >= viewEnd {
 			break
 		}
 
-		// Render the cell
-		cell := row[colIdx]
+		// Skip columns that are completely before the viewport
+		if currentPos+colWidth > viewStart {
+			start := max(viewStart-currentPos, 0)
+			end := min(colWidth, viewEnd-currentPos)
+			cell(colIdx, start, end)
+		}
+
+		currentPos += colWidth
+	}
+}
 
-		// Truncate if needed
-		if len(cell) > colWidth-2 {
-			cell = cell[:colWidth-3] + "…"
+// renderRow renders a single row with proper horizontal scrolling. Rows
+// with multi-line cells render as several lines separated by newlines.
+func (m Model) renderRow(row []string, rowIdx int, isHeader bool) string {
+	limit := 0
+	if !isHeader {
+		limit = m.bodyHeight()
+	}
+	height := m.rowHeight(row, rowIdx, isHeader, limit)
+
+	lines := make([]strings.Builder, height)
+	separator := m.theme.Border.Render(m.border.Left)
+
+	// Merged cells are laid out once and each column shows its part
+	type laidOut struct {
+		lines []string
+		style lipgloss.Style
+		first int
+	}
+	cells := make(map[int]laidOut)
+	overflows := m.overflows(row, rowIdx, isHeader)
+
+	layout := func(pos int) laidOut {
+		anchor, first, last := m.spanAt(rowIdx, pos)
+		over, overflowing := overflows[pos]
+		if overflowing {
+			anchor, first, last = cellPos{rowIdx, m.columnOrder[over.first]}, over.first, over.last
+		}
+		if cell, ok := cells[first]; ok {
+			return cell
 		}
 
-		// Pad the cell
-		cell = " " + cell + strings.Repeat(" ", colWidth-len(cell)-1)
+		colIdx := anchor.col
+		width := m.spanWidth(first, last)
 
-		// Handle partial visibility at the start
-		startOffset := 0
-		if currentPos < m.offsetX {
-			startOffset = m.offsetX - currentPos
+		// Rows below the first of a merged cell show it empty in its style
+		anchorRow := row
+		if anchor.row != rowIdx {
+			anchorRow = m.rows[anchor.row]
 		}
 
-		// Handle partial visibility at the end
-		endOffset := len(cell)
-		if currentPos+colWidth > m.offsetX+m.width {
-			endOffset = len(cell) - (currentPos + colWidth - m.offsetX - m.width)
+		cell := ""
+		if colIdx < len(anchorRow) {
+			cell = anchorRow[colIdx]
 		}
 
-		// Extract visible portion
-		if startOffset < endOffset {
-			visibleCell := cell[startOffset:endOffset]
+		style := m.cellStyle(anchor.row, colIdx, isHeader)
+		if m.styleFunc != nil {
+			state := m.cellState(anchor.row, colIdx, isHeader)
+			style = m.styleFunc(anchor.row, colIdx, cell, state).Inherit(style)
+		}
 
-			// Apply style
-			style := m.theme.Cell
+		var cellLines []string
+		if overflowing {
+			// Overflowing content keeps only the left padding
+			for _, line := range m.wrappedLines(row, rowIdx, colIdx, isHeader) {
+				cellLines = append(cellLines, ansi.Truncate(line, max(width-1, 0), ""))
+			}
+		} else if anchor.row == rowIdx {
+			cellLines = m.cellLines(row, rowIdx, colIdx, isHeader)
+		}
+		if len(cellLines) > height {
+			// Mark content cut off by the row height
+			last := cellLines[height-1] + m.ellipsis
+			cellLines[height-1] = ansi.Truncate(last, max(width-2, 0), m.ellipsis)
+		}
 
-			if isHeader {
-				style = m.theme.Header
+		padded := make([]string, height)
+		for i := range padded {
+			content := ""
+			if i < len(cellLines) {
+				content = cellLines[i]
+			}
+
+			// Pad the cell according to the column alignment; sections are centred
+			align := m.columnAligns[colIdx]
+			if !isHeader && m.rowKinds[rowIdx] == RowSection {
+				align = lipgloss.Center
+			}
+			if overflowing {
+				padded[i] = " " + content + strings.Repeat(" ", max(width-1-ansi.StringWidth(content), 0))
 			} else {
-				// Check for custom row style
-				if rowStyle, ok := m.rowStyles[rowIdx]; ok {
-					style = rowStyle
-				}
+				padded[i] = alignCell(content, width, align)
+			}
+		}
 
-				// Check for custom column style (takes precedence)
-				if colStyle, ok := m.columnStyles[colIdx]; ok {
-					style = colStyle
-				}
+		cells[first] = laidOut{padded, style, first}
+		return cells[first]
+	}
 
-				if m.HasSelectionMode(SelectionRow) && rowIdx == m.selectedRow {
-					style = m.theme.SelectedRow
-				}
-				if m.HasSelectionMode(SelectionColumn) && colIdx == m.selectedCol {
-					style = m.theme.SelectedCell
-				}
-				if m.HasSelectionMode(SelectionCell) && rowIdx == m.selectedRow && colIdx == m.selectedCol {
-					style = m.theme.SelectedCell
-				}
-			}
+	// write adds the part of a cell at [start, end) of the column at pos
+	write := func(pos, start, end int) {
+		cell := layout(pos)
+		offset := m.columnStart(pos) - m.columnStart(cell.first)
+		for i := range lines {
+			lines[i].WriteString(renderStyled(cell.style, ansi.Cut(cell.lines[i], offset+start, offset+end)))
+		}
+	}
 
-			result.WriteString(style.Render(visibleCell))
+	m.walkColumns(func(colIdx, start, end int) {
+		write(m.viewIndex(colIdx), start, end)
+	}, func(colIdx int) {
+		// Inside a merged cell the separator's place is filled with its content
+		if pos := m.viewIndex(colIdx); layout(pos).first < pos {
+			write(pos, -1, 0)
+			return
 		}
 
-		currentPos += colWidth
+		for i := range lines {
+			lines[i].WriteString(separator)
+		}
+	})
+
+	result := make([]string, height)
+	for i := range lines {
+		result[i] = m.renderGutter(rowIdx, i, isHeader) + lines[i].String()
+		if m.showFrame {
+			result[i] = m.theme.Border.Render(m.border.Left) + result[i] + m.theme.Border.Render(m.border.Right)
+		}
 	}
 
-	return result.String()
+	return strings.Join(result, "\n")
 }
 
-// renderBorder renders a horizontal border line
-func (m Model) renderBorder() string {
-	var result strings.Builder
-	currentPos := 0
+// alignCell pads content to width cells, keeping a space on either side,
+// and places it according to align
+func alignCell(content string, width int, align lipgloss.Position) string {
+	padding := max(width-ansi.StringWidth(content)-2, 0)
+	left := 0
+	switch align {
+	case lipgloss.Right:
+		left = padding
+	case lipgloss.Center:
+		left = padding / 2
+	}
 
-	for colIdx := range m.columnWidths {
-		colWidth := m.columnWidths[colIdx]
+	return " " + strings.Repeat(" ", left) + content + strings.Repeat(" ", padding-left+1)
+}
 
-		// Add intersection before column (except first)
-		if colIdx > 0 && m.showBorders {
-			if currentPos >= m.offsetX && currentPos < m.offsetX+m.width {
-				result.WriteString("┼")
-			}
-			currentPos++
-		}
+// cellStyle returns the style for a cell, applying custom styles, rules,
+// search matches and selection in increasing order of precedence
+func (m Model) cellStyle(rowIdx, colIdx int, isHeader bool) lipgloss.Style {
+	if isHeader {
+		return m.theme.Header
+	}
 
-		// Skip columns that are completely before the viewport
-		if currentPos+colWidth <= m.offsetX {
-			currentPos += colWidth
-			continue
-		}
+	switch m.rowKinds[rowIdx] {
+	case RowSection:
+		return m.theme.Section
+	case RowDisabled:
+		return m.theme.Disabled
+	}
 
-		// Stop if we've gone past the viewport
-		if currentPos >= m.offsetX+m.width {
-			break
-		}
+	style := m.theme.Cell
+	altRow := m.isAltRow(rowIdx)
+	if altRow {
+		style = m.theme.AltCell.Inherit(m.theme.Cell)
+	}
 
-		// Calculate visible portion of the border
-		startOffset := 0
-		if currentPos < m.offsetX {
-			startOffset = m.offsetX - currentPos
+	// stripe lets zebra stripes show through custom styles
+	stripe := func(style lipgloss.Style) lipgloss.Style {
+		if altRow {
+			return style.Inherit(m.theme.AltRow)
 		}
+		return style
+	}
 
-		visibleWidth := colWidth - startOffset
-		if currentPos+colWidth > m.offsetX+m.width {
-			visibleWidth = m.offsetX + m.width - currentPos - startOffset
-		}
+	if ruleStyle, ok := m.applyRules(style, RuleAboveTheme, rowIdx, colIdx); ok {
+		style = stripe(ruleStyle)
+	}
 
-		if visibleWidth > 0 {
-			result.WriteString(strings.Repeat("─", visibleWidth))
-		}
+	// Check for custom row style
+	if rowStyle, ok := m.rowStyles[rowIdx]; ok {
+		style = stripe(rowStyle)
+	}
 
-		currentPos += colWidth
+	if ruleStyle, ok := m.applyRules(style, RuleAboveRowStyle, rowIdx, colIdx); ok {
+		style = stripe(ruleStyle)
+	}
+
+	// Check for custom column style (takes precedence)
+	if colStyle, ok := m.columnStyles[colIdx]; ok {
+		style = stripe(colStyle)
+	}
+
+	if ruleStyle, ok := m.applyRules(style, RuleAboveColumnStyle, rowIdx, colIdx); ok {
+		style = stripe(ruleStyle)
+	}
+
+	if m.HasSelectionMode(SelectionRow) && rowIdx == m.selectedRow {
+		style = m.selectedRowStyle()
+	}
+
+	// Matches stay visible inside the selected row
+	if m.isMatch(rowIdx, colIdx) {
+		style = m.theme.Match
+	}
+
+	if m.HasSelectionMode(SelectionColumn) && colIdx == m.selectedCol {
+		style = m.selectedCellStyle()
+	}
+	if m.HasSelectionMode(SelectionCell) && rowIdx == m.selectedRow && colIdx == m.selectedCol {
+		style = m.selectedCellStyle()
+	}
+
+	style, _ = m.applyRules(style, RuleAboveSelection, rowIdx, colIdx)
+
+	return style
+}
+
+// cellState returns the state of a cell passed to the style function
+func (m Model) cellState(rowIdx, colIdx int, isHeader bool) CellState {
+	return CellState{
+		Header:   isHeader,
+		Selected: m.isSelected(rowIdx, colIdx),
+		Matched:  !isHeader && m.isMatch(rowIdx, colIdx),
+		Focused:  m.focus,
+	}
+}
+
+// isSelected reports whether a cell is highlighted by the selection modes.
+// Headers count as selected when their column is.
+func (m Model) isSelected(rowIdx, colIdx int) bool {
+	return (m.HasSelectionMode(SelectionRow) && rowIdx == m.selectedRow) ||
+		(m.HasSelectionMode(SelectionColumn) && colIdx == m.selectedCol) ||
+		(m.HasSelectionMode(SelectionCell) && rowIdx == m.selectedRow && colIdx == m.selectedCol)
+}
+
+// isAltRow reports whether a row gets the alternate zebra stripe styles.
+// Stripes follow the row's position among the rows holding data, so they
+// stay regular on either side of section rows.
+func (m Model) isAltRow(rowIdx int) bool {
+	position := rowIdx
+	for row, kind := range m.rowKinds {
+		if kind == RowSection && row < rowIdx {
+			position--
+		}
 	}
 
-	return m.theme.Border.Render(result.String())
+	return position%2 == 1
 }
 
 // GetSelectedRow returns the currently selected row index
@@ -609,7 +1203,7 @@ func (m Model) GetSelectedColumn() int {
 
 // GetSelectedCell returns the content of the currently selected cell
 func (m Model) GetSelectedCell() (string, bool) {
-	if m.selectedRow >= 0 && m.selectedRow < len(m.rows) &&
+	if m.isSelectable(m.selectedRow) &&
 		m.selectedCol >= 0 && m.selectedCol < len(m.rows[m.selectedRow]) {
 		return m.rows[m.selectedRow][m.selectedCol], true
 	}
@@ -629,7 +1223,7 @@ func (m Model) GetSelectionMode() SelectionMode {
 
 // ResetSelection resets the selection to the first cell
 func (m *Model) ResetSelection() {
-	m.selectedRow = 0
+	m.selectedRow = m.selectableRow(0, 1)
 	m.selectedCol = 0
 	m.offsetX = 0
 	m.offsetY = 0
@@ -637,11 +1231,10 @@ func (m *Model) ResetSelection() {
 
 // SetSelectedCell sets the selected cell by coordinates
 func (m *Model) SetSelectedCell(row, col int) {
-	if row < 0 || row >= len(m.rows) || col < 0 || col >= len(m.headers) {
+	if !m.isSelectable(row) || col < 0 || col >= len(m.headers) {
 		return
 	}
 
-	m.selectedRow = row
-	m.selectedCol = col
+	m.selectedRow, m.selectedCol = m.spanAnchor(row, col)
 	m.ensureVisible()
 }
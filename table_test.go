@@ -0,0 +1,74 @@
+package table
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestIsAltRow(t *testing.T) {
+	tests := []struct {
+		name     string
+		sections []int
+		want     []bool
+	}{
+		{"no sections", nil, []bool{false, true, false, true, false, true}},
+		{"section first", []int{0}, []bool{false, false, true, false, true, false}},
+		{"section inside", []int{2}, []bool{false, true, false, false, true, false}},
+		{"two sections", []int{1, 4}, []bool{false, true, true, false, false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kinds := make(map[int]RowKind)
+			for _, row := range tt.sections {
+				kinds[row] = RowSection
+			}
+			m := newRowsModel(len(tt.want), kinds)
+
+			for row, want := range tt.want {
+				if kinds[row] == RowSection {
+					continue
+				}
+				if got := m.isAltRow(row); got != want {
+					t.Errorf("isAltRow(%d) = %v, want %v", row, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestColumnKeysNeedColumnSelection(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       SelectionMode
+		wantHidden []int
+		wantOrder  []int
+	}{
+		{"row", SelectionRow, nil, []int{0, 1, 2}},
+		{"column", SelectionColumn, []int{1}, []int{0, 2, 1}},
+		{"cell", SelectionCell, []int{1}, []int{0, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"A", "B", "C"})
+			m.SetRows([][]string{{"a", "b", "c"}})
+			m.SetSize(40, 10)
+			m.SetSelectionMode(tt.mode)
+			m.selectedCol = 1
+
+			moved, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(">")})
+			if got := moved.ColumnOrder(); !slices.Equal(got, tt.wantOrder) {
+				t.Errorf("order after > = %v, want %v", got, tt.wantOrder)
+			}
+
+			hidden, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+			if got := hidden.HiddenColumns(); !slices.Equal(got, tt.wantHidden) {
+				t.Errorf("hidden after H = %v, want %v", got, tt.wantHidden)
+			}
+		})
+	}
+}
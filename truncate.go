@@ -0,0 +1,121 @@
+package table
+
+import "github.com/charmbracelet/x/ansi"
+
+// Truncation defines how content wider than its column is shortened
+type Truncation int
+
+const (
+	TruncateEnd    Truncation = iota // Keep the start: "/home/user/pro…"
+	TruncateStart                    // Keep the end: "…/project/main.go"
+	TruncateMiddle                   // Keep both ends: "/home/u…/main.go"
+	TruncateNone                     // Overflow into the empty cells after it and the padding, then clip without an ellipsis
+)
+
+// SetColumnTruncation sets how a column shortens content that does not fit
+func (m *Model) SetColumnTruncation(col int, mode Truncation) {
+	m.columnTruncation[col] = mode
+
+	m.overflowing = false
+	for _, mode := range m.columnTruncation {
+		m.overflowing = m.overflowing || mode == TruncateNone
+	}
+}
+
+// SetEllipsis sets the string marking truncated content, "…" by default
+func (m *Model) SetEllipsis(ellipsis string) {
+	m.ellipsis = ellipsis
+}
+
+// overflow is the displayed positions from first to last covered by a
+// cell that overflows its column
+type overflow struct {
+	first int
+	last  int
+}
+
+// overflows returns the cells of a row whose content overflows their
+// column, by every displayed position they cover. Such a cell runs on over
+// the empty cells after it, up to the width of its content, and then into
+// its right padding. Only unwrapped, unmerged cells of columns that don't
+// truncate overflow, and never into a cell highlighted differently.
+func (m Model) overflows(row []string, rowIdx int, isHeader bool) map[int]overflow {
+	if !m.overflowing {
+		return nil
+	}
+
+	// empty reports whether the cell at pos shows nothing
+	empty := func(pos int) bool {
+		colIdx := m.columnOrder[pos]
+		if _, ok := m.columnRenderers[colIdx]; ok && !isHeader {
+			return false
+		}
+		return colIdx >= len(row) || row[colIdx] == ""
+	}
+
+	result := make(map[int]overflow)
+	for pos := 0; pos < len(m.columnOrder); pos++ {
+		colIdx := m.columnOrder[pos]
+		if m.columnTruncation[colIdx] != TruncateNone || m.wrapColumns[colIdx] || empty(pos) {
+			continue
+		}
+		if _, first, last := m.spanAt(rowIdx, pos); first != last {
+			continue
+		}
+
+		needed := 0
+		for _, line := range m.wrappedLines(row, rowIdx, colIdx, isHeader) {
+			needed = max(needed, ansi.StringWidth(line))
+		}
+		if needed <= m.columnWidths[colIdx]-2 {
+			continue
+		}
+
+		last := pos
+		for next := pos + 1; next < len(m.columnOrder) && m.spanWidth(pos, last)-1 < needed; next++ {
+			if _, first, end := m.spanAt(rowIdx, next); first != end || !empty(next) ||
+				m.isSelected(rowIdx, m.columnOrder[next]) != m.isSelected(rowIdx, colIdx) {
+				break
+			}
+			last = next
+		}
+
+		for p := pos; p <= last; p++ {
+			result[p] = overflow{pos, last}
+		}
+		pos = last
+	}
+
+	return result
+}
+
+// truncate shortens a line to width cells using the given mode
+func truncate(line string, width int, mode Truncation, ellipsis string) string {
+	lineWidth := ansi.StringWidth(line)
+	if lineWidth <= width {
+		return line
+	}
+
+	if mode == TruncateNone {
+		return ansi.Truncate(line, width, "")
+	}
+
+	// Fall back to a clipped ellipsis when there is no room for content
+	ellipsisWidth := ansi.StringWidth(ellipsis)
+	if ellipsisWidth >= width {
+		return ansi.Truncate(ellipsis, width, "")
+	}
+
+	available := width - ellipsisWidth
+
+	switch mode {
+	case TruncateStart:
+		return ansi.TruncateLeft(line, lineWidth-available, ellipsis)
+	case TruncateMiddle:
+		left := ansi.Truncate(line, (available+1)/2, "")
+		right := ansi.TruncateLeft(line, lineWidth-available/2, "")
+		return left + ellipsis + right
+	default:
+		return ansi.Truncate(line, width, ellipsis)
+	}
+}
@@ -0,0 +1,80 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestTruncate(t *testing.T) {
+	const red = "\x1b[31m"
+	const reset = "\x1b[m"
+
+	tests := []struct {
+		name     string
+		line     string
+		width    int
+		mode     Truncation
+		ellipsis string
+		want     string
+	}{
+		{"fits", "main.go", 10, TruncateEnd, "…", "main.go"},
+		{"end", "/home/user/project", 10, TruncateEnd, "…", "/home/use…"},
+		{"start", "/home/user/project", 10, TruncateStart, "…", "…r/project"},
+		{"middle", "/home/user/project", 10, TruncateMiddle, "…", "/home…ject"},
+		{"none clips", "/home/user/project", 10, TruncateNone, "…", "/home/user"},
+		{"custom ellipsis", "abcdefghij", 6, TruncateEnd, "...", "abc..."},
+		{"ellipsis wider than width", "abcdefghij", 2, TruncateEnd, "...", ".."},
+		{"wide runes end", "日本語のテキスト", 7, TruncateEnd, "…", "日本語…"},
+		{"wide runes start", "日本語のテキスト", 7, TruncateStart, "…", "…キスト"},
+		{"wide runes middle", "日本語のテキスト", 7, TruncateMiddle, "…", "日…スト"},
+		{"ansi end", red + "abcdefghij" + reset, 5, TruncateEnd, "…", "abcd…"},
+		{"ansi start", red + "abcdefghij" + reset, 5, TruncateStart, "…", "…ghij"},
+		{"ansi middle", red + "abcdefghij" + reset, 5, TruncateMiddle, "…", "ab…ij"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.line, tt.width, tt.mode, tt.ellipsis)
+			if plain := ansi.Strip(got); plain != tt.want {
+				t.Errorf("truncate(%q, %d) = %q, want %q", tt.line, tt.width, plain, tt.want)
+			}
+			if w := ansi.StringWidth(got); w > tt.width {
+				t.Errorf("truncate(%q, %d) is %d cells wide", tt.line, tt.width, w)
+			}
+		})
+	}
+}
+
+func TestTruncateNoneOverflows(t *testing.T) {
+	tests := []struct {
+		name string
+		row  []string
+		want string
+	}{
+		{"into empty cells", []string{"a long value here", "", "", "x"}, " a long value │ x "},
+		{"into padding only", []string{"abcdef", "b", "", "x"}, " abcde│ b │   │ x "},
+		{"stops at content", []string{"a long value here", "", "c", "x"}, " a long va│ c │ x "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"A", "B", "C", "D"})
+			m.SetColumnWidth(0, 4)
+			m.SetColumnWidth(1, 1)
+			m.SetColumnWidth(2, 1)
+			m.SetColumnTruncation(0, TruncateNone)
+			m.SetSelectionMode(SelectionOff)
+			m.SetLayout(FitContentLayout{Compact: true})
+			m.SetRows([][]string{tt.row})
+			m.SetSize(60, 10)
+
+			got := ansi.Strip(m.renderRow(tt.row, 0, false))
+			if strings.TrimRight(got, " ") != strings.TrimRight(tt.want, " ") {
+				t.Errorf("row = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Column describes how a TypedModel displays one column of its items
+type Column[T any] struct {
+	Header    string
+	Value     func(item T) any // Returns the raw value of the cell
+	Formatter Formatter        // Formats the value, the default format if nil
+	Width     int              // Fixed content width, sized to content if zero
+	Align     lipgloss.Position
+}
+
+// TypedModel is a table bound to a slice of items. Rows are built from the
+// column accessors and selection accessors return items directly.
+type TypedModel[T any] struct {
+	Model
+
+	items   []T
+	columns []Column[T]
+}
+
+// NewTyped creates a table displaying items of type T with the given columns
+func NewTyped[T any](columns ...Column[T]) TypedModel[T] {
+	m := TypedModel[T]{
+		Model:   New(),
+		columns: columns,
+	}
+
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.Header
+		if col.Formatter != nil {
+			m.SetColumnFormatter(i, col.Formatter)
+		}
+		if col.Width > 0 {
+			m.SetColumnWidth(i, col.Width)
+		}
+		m.SetColumnAlign(i, col.Align)
+	}
+	m.SetHeaders(headers)
+
+	return m
+}
+
+// SetItems sets the items displayed in the table
+func (m *TypedModel[T]) SetItems(items []T) {
+	m.items = items
+
+	values := make([][]any, len(items))
+	for i, item := range items {
+		values[i] = make([]any, len(m.columns))
+		for j, col := range m.columns {
+			if col.Value != nil {
+				values[i][j] = col.Value(item)
+			}
+		}
+	}
+
+	m.SetValues(values)
+}
+
+// Items returns the items displayed in the table
+func (m TypedModel[T]) Items() []T {
+	return m.items
+}
+
+// SelectedItem returns the item in the currently selected row
+func (m TypedModel[T]) SelectedItem() (T, bool) {
+	var zero T
+	if !m.isSelectable(m.selectedRow) || m.selectedRow >= len(m.items) {
+		return zero, false
+	}
+
+	return m.items[m.selectedRow], true
+}
+
+// Update handles messages
+func (m TypedModel[T]) Update(msg tea.Msg) (TypedModel[T], tea.Cmd) {
+	var cmd tea.Cmd
+	m.Model, cmd = m.Model.Update(msg)
+	return m, cmd
+}
+
+// ColumnsFromTags derives columns from the exported fields of struct type T,
+// or of the struct T points to. The table tag sets the header and options:
+//
+//	Name   string  `table:"Full Name,width=20"`
+//	Salary float64 `table:"Salary,align=right"`
+//	Notes  string  `table:"-"`
+//
+// Fields without a tag use the field name as header; "-" skips the field.
+// Supported options are width=N and align=left|center|right.
+func ColumnsFromTags[T any]() ([]Column[T], error) {
+	typ := reflect.TypeFor[T]()
+	isPointer := typ.Kind() == reflect.Pointer
+	if isPointer {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("table: %s is not a struct", typ)
+	}
+
+	var columns []Column[T]
+	for _, field := range reflect.VisibleFields(typ) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		tag := field.Tag.Get("table")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+
+		col := Column[T]{
+			Header: name,
+			Value:  fieldAccessor[T](field.Index, isPointer),
+		}
+
+		if options != "" {
+			for option := range strings.SplitSeq(options, ",") {
+				key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+				switch key {
+				case "width":
+					width, err := strconv.Atoi(value)
+					if err != nil {
+						return nil, fmt.Errorf("table: field %s: invalid width %q", field.Name, value)
+					}
+					col.Width = width
+				case "align":
+					switch value {
+					case "left":
+						col.Align = lipgloss.Left
+					case "center":
+						col.Align = lipgloss.Center
+					case "right":
+						col.Align = lipgloss.Right
+					default:
+						return nil, fmt.Errorf("table: field %s: invalid align %q", field.Name, value)
+					}
+				default:
+					return nil, fmt.Errorf("table: field %s: unknown option %q", field.Name, key)
+				}
+			}
+		}
+
+		columns = append(columns, col)
+	}
+
+	return columns, nil
+}
+
+// NewTypedFromTags creates a table with columns derived from the struct tags of T
+func NewTypedFromTags[T any]() (TypedModel[T], error) {
+	columns, err := ColumnsFromTags[T]()
+	if err != nil {
+		return TypedModel[T]{}, err
+	}
+
+	return NewTyped(columns...), nil
+}
+
+// fieldAccessor returns a function reading the field at index from an item
+func fieldAccessor[T any](index []int, isPointer bool) func(T) any {
+	return func(item T) any {
+		v := reflect.ValueOf(item)
+		if isPointer {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+
+		field, err := v.FieldByIndexErr(index)
+		if err != nil {
+			// A nil embedded pointer on the way to the field
+			return nil
+		}
+
+		return field.Interface()
+	}
+}
@@ -0,0 +1,156 @@
+package table
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type person struct {
+	ID int `table:"ID"`
+}
+
+type employee struct {
+	person
+	Name   string  `table:"Full Name,width=20"`
+	Salary float64 `table:"Salary, align=right"`
+	Notes  string  `table:"-"`
+	Dept   string
+	secret string
+}
+
+type contact struct {
+	*person
+	Email string `table:"Email,align=center"`
+}
+
+type badWidth struct {
+	A string `table:"A,width=wide"`
+}
+
+type badAlign struct {
+	A string `table:"A,align=top"`
+}
+
+type unknownOption struct {
+	A string `table:"A,bold"`
+}
+
+func TestColumnsFromTags(t *testing.T) {
+	columns, err := ColumnsFromTags[employee]()
+	if err != nil {
+		t.Fatalf("ColumnsFromTags() error = %v", err)
+	}
+
+	want := []struct {
+		header string
+		width  int
+		align  lipgloss.Position
+		value  any
+	}{
+		{"ID", 0, lipgloss.Left, 7},
+		{"Full Name", 20, lipgloss.Left, "Ann"},
+		{"Salary", 0, lipgloss.Right, 125000.0},
+		{"Dept", 0, lipgloss.Left, "Eng"},
+	}
+	if len(columns) != len(want) {
+		t.Fatalf("got %d columns, want %d", len(columns), len(want))
+	}
+
+	item := employee{person: person{ID: 7}, Name: "Ann", Salary: 125000, Notes: "n", Dept: "Eng", secret: "s"}
+	for i, w := range want {
+		col := columns[i]
+		if col.Header != w.header || col.Width != w.width || col.Align != w.align {
+			t.Errorf("column %d = {%q, %d, %v}, want {%q, %d, %v}", i, col.Header, col.Width, col.Align, w.header, w.width, w.align)
+		}
+		if got := col.Value(item); got != w.value {
+			t.Errorf("column %q value = %v, want %v", w.header, got, w.value)
+		}
+	}
+}
+
+func TestColumnsFromTagsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns func() error
+		want    string
+	}{
+		{"invalid width", func() error { _, err := ColumnsFromTags[badWidth](); return err }, `invalid width "wide"`},
+		{"invalid align", func() error { _, err := ColumnsFromTags[badAlign](); return err }, `invalid align "top"`},
+		{"unknown option", func() error { _, err := ColumnsFromTags[unknownOption](); return err }, `unknown option "bold"`},
+		{"not a struct", func() error { _, err := ColumnsFromTags[int](); return err }, "not a struct"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.columns(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnsFromTagsPointers(t *testing.T) {
+	columns, err := ColumnsFromTags[*contact]()
+	if err != nil {
+		t.Fatalf("ColumnsFromTags() error = %v", err)
+	}
+
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.Header
+	}
+	if want := []string{"ID", "Email"}; !slices.Equal(headers, want) {
+		t.Fatalf("headers = %v, want %v", headers, want)
+	}
+	if columns[1].Align != lipgloss.Center {
+		t.Errorf("Email align = %v, want center", columns[1].Align)
+	}
+
+	tests := []struct {
+		name string
+		item *contact
+		want []any
+	}{
+		{"nil item", nil, []any{nil, nil}},
+		{"nil embedded struct", &contact{Email: "a@example.com"}, []any{nil, "a@example.com"}},
+		{"set", &contact{person: &person{ID: 3}, Email: "b@example.com"}, []any{3, "b@example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, col := range columns {
+				if got := col.Value(tt.item); got != tt.want[i] {
+					t.Errorf("column %q value = %v, want %v", col.Header, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSelectedItem(t *testing.T) {
+	m, err := NewTypedFromTags[employee]()
+	if err != nil {
+		t.Fatalf("NewTypedFromTags() error = %v", err)
+	}
+	m.SetSize(60, 10)
+
+	if _, ok := m.SelectedItem(); ok {
+		t.Error("SelectedItem() found an item in an empty table")
+	}
+
+	items := []employee{{Name: "Ann"}, {Name: "Bob"}, {Name: "Cy"}}
+	m.SetItems(items)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	got, ok := m.SelectedItem()
+	if !ok || got.Name != "Bob" {
+		t.Errorf("SelectedItem() = %q, %v, want %q, true", got.Name, ok, "Bob")
+	}
+	if rows := m.DataRowCount(); rows != len(items) {
+		t.Errorf("DataRowCount() = %d, want %d", rows, len(items))
+	}
+}
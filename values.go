@@ -0,0 +1,103 @@
+package table
+
+import (
+	"fmt"
+	"time"
+)
+
+// Formatter converts a raw cell value into the text displayed in the table
+type Formatter func(value any) string
+
+// SetValues sets the table rows from typed values. The raw values are kept
+// for renderers, rules, search and GetValue, and each cell is displayed
+// using the column's formatter, or a default format if the column has none.
+// Search matches a cell by its displayed text or its raw value, so 125000
+// finds a salary shown as "$125,000.00".
+func (m *Model) SetValues(values [][]any) {
+	m.values = values
+	clear(m.rowKinds)
+	m.setRows(m.formatValues())
+}
+
+// SetColumnFormatter sets the formatter used to display typed values in a
+// column. Pass nil to use the default format.
+func (m *Model) SetColumnFormatter(col int, formatter Formatter) {
+	if formatter == nil {
+		delete(m.columnFormatters, col)
+	} else {
+		m.columnFormatters[col] = formatter
+	}
+
+	if m.values != nil {
+		m.setRows(m.formatValues())
+	}
+}
+
+// GetValue returns the raw value of a cell. For tables populated with
+// SetRows, the value is the cell's string.
+func (m Model) GetValue(row, col int) (any, bool) {
+	if row < 0 || row >= len(m.rows) || col < 0 || col >= len(m.rows[row]) {
+		return nil, false
+	}
+
+	return m.cellValue(row, col), true
+}
+
+// GetSelectedValue returns the raw value of the currently selected cell
+func (m Model) GetSelectedValue() (any, bool) {
+	if !m.isSelectable(m.selectedRow) {
+		return nil, false
+	}
+
+	return m.GetValue(m.selectedRow, m.selectedCol)
+}
+
+// cellValue returns the raw value of a cell, falling back to its text. It
+// returns nil for cells missing from a short row.
+func (m Model) cellValue(rowIdx, colIdx int) any {
+	if rowIdx < 0 || colIdx < 0 {
+		return nil
+	}
+	if rowIdx < len(m.values) && colIdx < len(m.values[rowIdx]) {
+		return m.values[rowIdx][colIdx]
+	}
+	if rowIdx < len(m.rows) && colIdx < len(m.rows[rowIdx]) {
+		return m.rows[rowIdx][colIdx]
+	}
+
+	return nil
+}
+
+// formatValues converts the typed values into displayed rows
+func (m Model) formatValues() [][]string {
+	rows := make([][]string, len(m.values))
+	for i, values := range m.values {
+		rows[i] = make([]string, len(values))
+		for j, value := range values {
+			if formatter, ok := m.columnFormatters[j]; ok {
+				rows[i][j] = formatter(value)
+			} else {
+				rows[i][j] = formatValue(value)
+			}
+		}
+	}
+
+	return rows
+}
+
+// formatValue is the default format for values without a formatter
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 {
+			return v.Format(time.DateOnly)
+		}
+		return v.Format(time.DateTime)
+	}
+
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,122 @@
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"nil", nil, ""},
+		{"string", "hello", "hello"},
+		{"int", 42, "42"},
+		{"negative float", -1.5, "-1.5"},
+		{"bool", true, "true"},
+		{"date", time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), "2024-03-09"},
+		{"date time", time.Date(2024, 3, 9, 14, 5, 0, 0, time.UTC), "2024-03-09 14:05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatValue(tt.value); got != tt.want {
+				t.Errorf("formatValue(%v) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatters(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter Formatter
+		value     any
+		want      string
+	}{
+		{"number small", NumberFormatter(0), 999, "999"},
+		{"number thousands", NumberFormatter(0), 1000, "1,000"},
+		{"number millions", NumberFormatter(2), 1234567.891, "1,234,567.89"},
+		{"number negative", NumberFormatter(0), -1234567, "-1,234,567"},
+		{"number negative small", NumberFormatter(1), -12.34, "-12.3"},
+		{"number not numeric", NumberFormatter(0), "n/a", "n/a"},
+		{"fixed", FixedFormatter(2), 1234.5, "1234.50"},
+		{"currency", CurrencyFormatter("$", 0), 120000, "$120,000"},
+		{"currency negative", CurrencyFormatter("$", 2), -1250.5, "-$1,250.50"},
+		{"time", TimeFormatter("Jan 2"), time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), "Mar 9"},
+		{"bytes", BytesFormatter(), 512, "512 B"},
+		{"bytes kib", BytesFormatter(), 1536, "1.5 KiB"},
+		{"bytes mib", BytesFormatter(), 5 * 1024 * 1024, "5.0 MiB"},
+		{"bytes negative", BytesFormatter(), -2048, "-2.0 KiB"},
+		{"duration seconds", DurationFormatter(), 45 * time.Second, "45s"},
+		{"duration two units", DurationFormatter(), 2*time.Hour + 5*time.Minute + 7*time.Second, "2h 5m"},
+		{"duration days", DurationFormatter(), 76 * time.Hour, "3d 4h"},
+		{"duration negative", DurationFormatter(), -90 * time.Second, "-1m 30s"},
+		{"duration sub second", DurationFormatter(), 250 * time.Millisecond, "250ms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.formatter(tt.value); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCellValueShortRows(t *testing.T) {
+	tests := []struct {
+		name   string
+		values [][]any
+		rows   [][]string
+	}{
+		{"short string row", nil, [][]string{{"a", "b", "50"}, {"c"}}},
+		{"short value row", [][]any{{"a", "b", 50}, {"c"}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"A", "B", "C"})
+			m.SetColumnRenderer(2, BarRenderer{})
+			if tt.values != nil {
+				m.SetValues(tt.values)
+			} else {
+				m.SetRows(tt.rows)
+			}
+			m.SetSize(60, 10)
+
+			_ = m.View()
+			if v := m.cellValue(1, 2); v != nil {
+				t.Errorf("cellValue of missing cell = %v, want nil", v)
+			}
+		})
+	}
+}
+
+func TestSearchMatchesRawValues(t *testing.T) {
+	m := New()
+	m.SetHeaders([]string{"Name", "Salary"})
+	m.SetColumnFormatter(1, CurrencyFormatter("$", 0))
+	m.SetValues([][]any{{"Ann", 125000}, {"Bob", 90000}})
+	m.SetSize(40, 10)
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"125000", 1},   // Raw value
+		{"$125,000", 1}, // Displayed text
+		{"bob", 1},
+		{"zzz", 0},
+	}
+
+	for _, tt := range tests {
+		m.SetSearch(tt.query)
+		if got := len(m.searchMatches); got != tt.want {
+			t.Errorf("SetSearch(%q) matched %d cells, want %d", tt.query, got, tt.want)
+		}
+	}
+}
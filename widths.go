@@ -0,0 +1,121 @@
+package table
+
+import (
+	"math"
+	"slices"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// WidthSampling sizes columns from a sample of the rows instead of the
+// widest cell, so a few very long values don't widen the whole column and
+// large tables aren't scanned in full
+type WidthSampling struct {
+	Rows       int     // Rows measured from the top, every row if zero
+	Percentile float64 // Percentile of the cell widths used, such as 95; the widest cell if zero
+}
+
+// SetWidthSampling sets how cells are measured to size the columns
+func (m *Model) SetWidthSampling(sampling WidthSampling) {
+	m.widthSampling = sampling
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// SetColumnMinWidth sets the narrowest content width of a column under any
+// layout. Zero removes the limit.
+func (m *Model) SetColumnMinWidth(col, width int) {
+	if width <= 0 {
+		delete(m.minWidths, col)
+	} else {
+		m.minWidths[col] = width
+	}
+
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// SetColumnMaxWidth sets the widest content width of a column under any
+// layout. Longer content is truncated. Zero removes the limit.
+func (m *Model) SetColumnMaxWidth(col, width int) {
+	if width <= 0 {
+		delete(m.maxWidths, col)
+	} else {
+		m.maxWidths[col] = width
+	}
+
+	m.calculateColumnWidths()
+	m.ensureVisible()
+}
+
+// measureRows widens each column to fit its cells, measuring the sampled
+// rows and taking the configured percentile of their widths
+func (m *Model) measureRows(numCols int) {
+	rows := m.rows
+	if m.widthSampling.Rows > 0 && len(rows) > m.widthSampling.Rows {
+		rows = rows[:m.widthSampling.Rows]
+	}
+
+	if m.widthSampling.Percentile <= 0 {
+		for rowIdx, row := range rows {
+			for i, cell := range row {
+				if !m.measured(rowIdx, i, numCols) {
+					continue
+				}
+				m.columnWidths[i] = max(m.columnWidths[i], ansi.StringWidth(cell))
+			}
+		}
+		return
+	}
+
+	samples := make([][]int, numCols)
+	for rowIdx, row := range rows {
+		for i, cell := range row {
+			if !m.measured(rowIdx, i, numCols) {
+				continue
+			}
+			samples[i] = append(samples[i], ansi.StringWidth(cell))
+		}
+	}
+
+	for i, widths := range samples {
+		m.columnWidths[i] = max(m.columnWidths[i], percentile(widths, m.widthSampling.Percentile))
+	}
+}
+
+// measured reports whether a cell's content counts towards the width of its
+// column. Rendered columns size themselves and cells merged across columns,
+// including section rows, take the space of the columns they cover.
+func (m Model) measured(rowIdx, colIdx, numCols int) bool {
+	if _, ok := m.columnRenderers[colIdx]; ok || colIdx >= numCols || m.rowKinds[rowIdx] == RowSection {
+		return false
+	}
+
+	span, ok := m.cellSpans[cellPos{rowIdx, colIdx}]
+	return !ok || span.cols <= 1
+}
+
+// limitWidths clamps the content width of each column to its minimum and
+// maximum width
+func (m *Model) limitWidths() {
+	for i := range m.columnWidths {
+		if w, ok := m.maxWidths[i]; ok {
+			m.columnWidths[i] = min(m.columnWidths[i], w)
+		}
+		if w, ok := m.minWidths[i]; ok {
+			m.columnWidths[i] = max(m.columnWidths[i], w)
+		}
+	}
+}
+
+// percentile returns the nearest-rank percentile of values, 0 if empty
+func percentile(values []int, p float64) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	slices.Sort(values)
+	rank := int(math.Ceil(min(p, 100) / 100 * float64(len(values))))
+
+	return values[max(rank, 1)-1]
+}
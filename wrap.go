@@ -0,0 +1,206 @@
+package table
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// SetColumnWrap sets whether a column wraps long text onto several lines
+// instead of truncating it. Rows grow to fit their tallest cell, up to the
+// maximum row height. Combine with SetColumnWidth to bound the column.
+func (m *Model) SetColumnWrap(col int, wrap bool) {
+	if wrap {
+		m.wrapColumns[col] = true
+	} else {
+		delete(m.wrapColumns, col)
+	}
+
+	m.invalidateHeights()
+	m.ensureVisible()
+}
+
+// SetMaxRowHeight limits how many lines a row can grow to. Content beyond
+// the limit is cut off with an ellipsis. Zero means no limit other than the
+// height of the table.
+func (m *Model) SetMaxRowHeight(lines int) {
+	m.maxRowHeight = max(lines, 0)
+	m.ensureVisible()
+}
+
+// cellLines returns the lines of a cell's content fitted to the column width
+func (m Model) cellLines(row []string, rowIdx, colIdx int, isHeader bool) []string {
+	width := max(m.cellWidth(rowIdx, colIdx)-2, 0)
+
+	lines := m.wrappedLines(row, rowIdx, colIdx, isHeader)
+	for i, line := range lines {
+		lines[i] = truncate(line, width, m.columnTruncation[colIdx], m.ellipsis)
+	}
+
+	return lines
+}
+
+// wrappedLines returns the lines of a cell's content before truncation.
+// Embedded newlines always start a new line; wrapped columns also break
+// lines that are too wide.
+func (m Model) wrappedLines(row []string, rowIdx, colIdx int, isHeader bool) []string {
+	width := max(m.cellWidth(rowIdx, colIdx)-2, 0)
+
+	// Leave missing trailing cells empty
+	content := ""
+	if colIdx < len(row) {
+		content = row[colIdx]
+	}
+
+	if renderer, ok := m.columnRenderers[colIdx]; ok && !isHeader {
+		state := m.cellState(rowIdx, colIdx, isHeader)
+		content = renderer.Render(m.cellValue(rowIdx, colIdx), width, state)
+	}
+
+	lines := strings.Split(content, "\n")
+	if m.wrapColumns[colIdx] && width > 0 {
+		var wrapped []string
+		for _, line := range lines {
+			wrapped = append(wrapped, strings.Split(ansi.Wrap(line, width, ""), "\n")...)
+		}
+		lines = wrapped
+	}
+
+	return lines
+}
+
+// rowHeights caches the line count of the tallest cell of each row by row
+// index, -1 for the headers. Copies of a model share the cache, which is
+// emptied when it was filled for another version of the layout.
+type rowHeights struct {
+	version int64
+	heights map[int]int
+}
+
+// layoutVersions hands out layout versions. Every invalidation takes a new
+// one, so copies of a model that change apart never share a version.
+var layoutVersions atomic.Int64
+
+// invalidateHeights drops the cached row heights after a change to the
+// rows, the column widths or anything else deciding how cells are laid out
+func (m *Model) invalidateHeights() {
+	m.layoutVersion = layoutVersions.Add(1)
+}
+
+// rowHeight returns the number of lines a row takes, which is the line
+// count of its tallest cell capped by the maximum row height and limit
+func (m Model) rowHeight(row []string, rowIdx int, isHeader bool, limit int) int {
+	height := m.naturalHeight(row, rowIdx, isHeader)
+
+	if m.maxRowHeight > 0 {
+		height = min(height, m.maxRowHeight)
+	}
+	if limit > 0 {
+		height = min(height, limit)
+	}
+
+	return height
+}
+
+// naturalHeight returns the line count of the tallest cell of a row,
+// measuring the row only when it isn't cached
+func (m Model) naturalHeight(row []string, rowIdx int, isHeader bool) int {
+	if isHeader {
+		rowIdx = -1
+	}
+
+	cache := m.heights
+	if cache != nil {
+		if cache.version != m.layoutVersion {
+			cache.version = m.layoutVersion
+			clear(cache.heights)
+		}
+		if height, ok := cache.heights[rowIdx]; ok {
+			return height
+		}
+	}
+
+	height := 1
+	for _, colIdx := range m.columnOrder {
+		// Merged cells count towards their first row only
+		if !isHeader && m.coveredBySpan(rowIdx, colIdx) {
+			continue
+		}
+		height = max(height, len(m.cellLines(row, rowIdx, colIdx, isHeader)))
+	}
+
+	if cache != nil {
+		cache.heights[rowIdx] = height
+	}
+
+	return height
+}
+
+// bodyHeight returns the number of lines left for rows once the frame,
+// column groups, headers, header separator and search input have taken theirs
+func (m Model) bodyHeight() int {
+	availableHeight := m.height - m.scrollbarHeight() - m.statusHeight()
+	if m.showFrame {
+		availableHeight -= 2
+	}
+	if m.showHeaders {
+		availableHeight -= m.groupHeight()
+		availableHeight -= m.rowHeight(m.headers, -1, true, 0)
+		if m.showHeaderSeparator {
+			availableHeight--
+		}
+	}
+	if m.searching {
+		availableHeight--
+	}
+
+	return max(availableHeight, 0)
+}
+
+// rowsFitting returns how many rows starting at from fit in the body. Row
+// separators take one line between each pair of rows. A row taller than the
+// body is cut to fit, so at least one row fits whenever the body has room.
+func (m Model) rowsFitting(from int) int {
+	bodyHeight := m.bodyHeight()
+	used := 0
+	count := 0
+
+	for rowIdx := from; rowIdx < len(m.rows); rowIdx++ {
+		needed := m.rowHeight(m.rows[rowIdx], rowIdx, false, bodyHeight)
+		if count > 0 && m.showRowSeparators {
+			needed++
+		}
+		if used+needed > bodyHeight {
+			break
+		}
+
+		used += needed
+		count++
+	}
+
+	return count
+}
+
+// firstRowShowing returns the smallest offset at which rows from the offset
+// through row fit in the body
+func (m Model) firstRowShowing(row int) int {
+	bodyHeight := m.bodyHeight()
+	used := m.rowHeight(m.rows[row], row, false, bodyHeight)
+	first := row
+
+	for first > 0 {
+		needed := m.rowHeight(m.rows[first-1], first-1, false, bodyHeight)
+		if m.showRowSeparators {
+			needed++
+		}
+		if used+needed > bodyHeight {
+			break
+		}
+
+		used += needed
+		first--
+	}
+
+	return first
+}
@@ -0,0 +1,108 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// countingRenderer counts the cells it renders
+type countingRenderer struct {
+	calls *int
+}
+
+func (r countingRenderer) Render(value any, _ int, _ CellState) string {
+	*r.calls++
+	return fmt.Sprint(value)
+}
+
+func (r countingRenderer) MinWidth() int {
+	return 1
+}
+
+func TestRowHeightsAreCached(t *testing.T) {
+	calls := 0
+	m := New()
+	m.SetHeaders([]string{"Name", "Value"})
+	m.SetColumnRenderer(1, countingRenderer{&calls})
+
+	rows := make([][]string, 100)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprintf("row %d", i), "v"}
+	}
+	m.SetRows(rows)
+	m.SetSize(40, 10)
+
+	_ = m.View()
+	calls = 0
+	_ = m.View()
+
+	// Only the visible rows are rendered, and their heights come from the cache
+	if visible := m.visibleRowCount(); calls != visible {
+		t.Errorf("second View rendered %d cells, want %d", calls, visible)
+	}
+}
+
+func TestRowHeightsFollowChanges(t *testing.T) {
+	long := strings.Repeat("word ", 8)
+
+	tests := []struct {
+		name   string
+		change func(m *Model)
+		want   int
+	}{
+		{"unchanged", func(m *Model) {}, 1},
+		{"wrap enabled", func(m *Model) { m.SetColumnWrap(0, true) }, 4},
+		{"narrower column", func(m *Model) {
+			m.SetColumnWrap(0, true)
+			m.SetColumnWidth(0, 5)
+		}, 8},
+		{"new rows", func(m *Model) {
+			m.SetColumnWrap(0, true)
+			m.SetRows([][]string{{"short"}})
+		}, 1},
+		{"embedded newlines", func(m *Model) { m.SetRows([][]string{{"a\nb\nc"}}) }, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.SetHeaders([]string{"Text"})
+			m.SetRows([][]string{{long}})
+			m.SetColumnWidth(0, 10)
+			m.SetSize(40, 20)
+
+			// Fill the cache before the change
+			_ = m.View()
+			tt.change(&m)
+
+			if got := m.rowHeight(m.rows[0], 0, false, 0); got != tt.want {
+				t.Errorf("row height = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRowHeightsOfCopies(t *testing.T) {
+	base := New()
+	base.SetHeaders([]string{"Text"})
+	base.SetColumnWrap(0, true)
+	base.SetColumnWidth(0, 10)
+	base.SetSize(40, 20)
+
+	a, b := base, base
+	a.SetRows([][]string{{strings.Repeat("word ", 6)}})
+	b.SetRows([][]string{{"short"}})
+
+	// Both copies fill the shared cache in turn
+	_ = a.View()
+	_ = b.View()
+	_ = a.View()
+
+	if got := a.rowHeight(a.rows[0], 0, false, 0); got != 3 {
+		t.Errorf("a row height = %d, want 3", got)
+	}
+	if got := b.rowHeight(b.rows[0], 0, false, 0); got != 1 {
+		t.Errorf("b row height = %d, want 1", got)
+	}
+}